@@ -0,0 +1,229 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+type AvailablePhoneNumberService struct {
+	client *Client
+}
+
+// AvailableNumberType is the kind of phone numbers to search for.
+type AvailableNumberType string
+
+const (
+	AvailableLocal    AvailableNumberType = "Local"
+	AvailableTollFree AvailableNumberType = "TollFree"
+	AvailableMobile   AvailableNumberType = "Mobile"
+)
+
+// subresourceKeys maps number types to their key in AvailableCountry.SubresourceUris.
+var subresourceKeys = map[AvailableNumberType]string{
+	AvailableLocal:    "local",
+	AvailableTollFree: "toll_free",
+	AvailableMobile:   "mobile",
+}
+
+// AvailableCountry is a country where Twilio sells phone numbers.
+type AvailableCountry struct {
+	CountryCode     string            `json:"country_code"`
+	Country         string            `json:"country"`
+	Beta            bool              `json:"beta"`
+	SubresourceUris map[string]string `json:"subresource_uris"`
+	Uri             string            `json:"uri"`
+}
+
+// AvailableNumberTypes returns the kinds of numbers sold in the country.
+func (c AvailableCountry) AvailableNumberTypes() []AvailableNumberType {
+	var types []AvailableNumberType
+	for _, t := range []AvailableNumberType{AvailableLocal, AvailableTollFree, AvailableMobile} {
+		if _, ok := c.SubresourceUris[subresourceKeys[t]]; ok {
+			types = append(types, t)
+		}
+	}
+
+	return types
+}
+
+type AvailablePhoneNumber struct {
+	FriendlyName        string       `json:"friendly_name"`
+	PhoneNumber         string       `json:"phone_number"`
+	Lata                string       `json:"lata"`
+	RateCenter          string       `json:"rate_center"`
+	Latitude            float64      `json:"latitude,string"`
+	Longitude           float64      `json:"longitude,string"`
+	Locality            string       `json:"locality"`
+	Region              string       `json:"region"`
+	PostalCode          string       `json:"postal_code"`
+	IsoCountry          string       `json:"iso_country"`
+	AddressRequirements string       `json:"address_requirements"`
+	Beta                bool         `json:"beta"`
+	Capabilities        Capabilities `json:"capabilities"`
+}
+
+type AvailablePhoneNumberParams struct {
+	// Local numbers only, US and Canada.
+	AreaCode string
+
+	// Pattern matched against the numbers: digits, letters (mapped to keypad digits) and "*" matching any digit.
+	Contains string
+
+	// Local numbers only, US and Canada.
+	InRegion     string
+	InPostalCode string
+	InLocality   string
+	InRateCenter string
+	InLata       string
+
+	// Search near a "latitude,longitude" point or a phone number, within Distance miles (up to 500, defaults to 25).
+	NearLatLong string
+	NearNumber  string
+	Distance    int
+
+	SmsEnabled   *bool
+	MmsEnabled   *bool
+	VoiceEnabled *bool
+	FaxEnabled   *bool
+
+	ExcludeAllAddressRequired     *bool
+	ExcludeLocalAddressRequired   *bool
+	ExcludeForeignAddressRequired *bool
+
+	Beta     *bool
+	PageSize int
+}
+
+var (
+	containsPattern = regexp.MustCompile(`^[0-9A-Za-z*+]{2,16}$`)
+	latLongPattern  = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?,-?[0-9]+(\.[0-9]+)?$`)
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// Maximum value of AvailablePhoneNumberParams.Distance.
+const maxSearchDistance = 500
+
+func (p AvailablePhoneNumberParams) Validates() error {
+	if p.AreaCode != "" {
+		if _, err := strconv.Atoi(p.AreaCode); err != nil || len(p.AreaCode) != 3 {
+			return errors.New(`"AreaCode" must be 3 digits.`)
+		}
+	}
+
+	if p.Contains != "" && !containsPattern.MatchString(p.Contains) {
+		return errors.New(`"Contains" must be 2 to 16 digits, letters or "*".`)
+	}
+
+	if p.NearLatLong != "" && !latLongPattern.MatchString(p.NearLatLong) {
+		return errors.New(`"NearLatLong" must be formatted as "latitude,longitude".`)
+	}
+
+	if p.Distance != 0 {
+		if p.NearLatLong == "" && p.NearNumber == "" {
+			return errors.New(`"Distance" requires "NearLatLong" or "NearNumber".`)
+		}
+
+		if p.Distance < 0 || p.Distance > maxSearchDistance {
+			return errors.New(`"Distance" must be between 1 and 500.`)
+		}
+	}
+
+	return nil
+}
+
+// Countries lists the countries where phone numbers can be bought.
+func (s *AvailablePhoneNumberService) Countries() ([]AvailableCountry, *Response, error) {
+	return s.CountriesContext(context.Background())
+}
+
+// CountriesContext is like Countries but bound to ctx.
+func (s *AvailablePhoneNumberService) CountriesContext(ctx context.Context) ([]AvailableCountry, *Response, error) {
+	u := s.client.EndPoint("AvailablePhoneNumbers")
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Countries []AvailableCountry `json:"countries"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l.Countries, resp, err
+}
+
+// Search looks up numbers of the given type available for purchase in country, an ISO 3166-1 alpha-2 code like "US".
+// Found numbers can be bought with IncomingPhoneNumberService.Purchase:
+//
+//	nl, _, err := c.AvailablePhoneNumbers.Search("US", twilio.AvailableLocal, twilio.AvailablePhoneNumberParams{AreaCode: "415"})
+//	if err == nil && len(nl) > 0 {
+//		c.IncomingPhoneNumbers.Purchase(nl[0].PhoneNumber, twilio.IncomingPhoneNumberParams{})
+//	}
+func (s *AvailablePhoneNumberService) Search(country string, kind AvailableNumberType, params AvailablePhoneNumberParams) ([]AvailablePhoneNumber, *Response, error) {
+	return s.SearchContext(context.Background(), country, kind, params)
+}
+
+// SearchContext is like Search but bound to ctx.
+func (s *AvailablePhoneNumberService) SearchContext(ctx context.Context, country string, kind AvailableNumberType, params AvailablePhoneNumberParams) ([]AvailablePhoneNumber, *Response, error) {
+	if !countryPattern.MatchString(country) {
+		return nil, nil, fmt.Errorf("Country must be an ISO 3166-1 alpha-2 code, got %q.", country)
+	}
+
+	switch kind {
+	case AvailableLocal, AvailableTollFree, AvailableMobile:
+	default:
+		return nil, nil, fmt.Errorf("Unknown phone number type %q.", kind)
+	}
+
+	err := params.Validates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := s.client.EndPoint("AvailablePhoneNumbers", country, string(kind))
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		AvailablePhoneNumbers []AvailablePhoneNumber `json:"available_phone_numbers"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l.AvailablePhoneNumbers, resp, err
+}
+
+// ErrNoAvailableNumber is returned by SearchAndPurchase when the search matched no number.
+var ErrNoAvailableNumber = errors.New("twilio: no available phone number matches the search")
+
+// SearchAndPurchase buys the first number matching the search and configures it with config.
+func (s *AvailablePhoneNumberService) SearchAndPurchase(country string, kind AvailableNumberType, params AvailablePhoneNumberParams, config IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	return s.SearchAndPurchaseContext(context.Background(), country, kind, params, config)
+}
+
+// SearchAndPurchaseContext is like SearchAndPurchase but bound to ctx.
+func (s *AvailablePhoneNumberService) SearchAndPurchaseContext(ctx context.Context, country string, kind AvailableNumberType, params AvailablePhoneNumberParams, config IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	params.PageSize = 1
+
+	nl, resp, err := s.SearchContext(ctx, country, kind, params)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(nl) == 0 {
+		return nil, resp, ErrNoAvailableNumber
+	}
+
+	return s.client.IncomingPhoneNumbers.PurchaseContext(ctx, nl[0].PhoneNumber, config)
+}
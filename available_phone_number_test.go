@@ -0,0 +1,157 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAvailablePhoneNumberParams_Validates(t *testing.T) {
+	for _, p := range []AvailablePhoneNumberParams{
+		{AreaCode: "41"},
+		{AreaCode: "abc"},
+		{Contains: "5-5"},
+		{NearLatLong: "37.7"},
+		{Distance: 10},
+		{NearNumber: "+14155550006", Distance: 501},
+	} {
+		if err := p.Validates(); err == nil {
+			t.Errorf("AvailablePhoneNumberParams(%+v).Validates expected an error to be returned", p)
+		}
+	}
+
+	p := AvailablePhoneNumberParams{AreaCode: "415", Contains: "55*", NearLatLong: "37.840699,-122.461853", Distance: 50}
+	if err := p.Validates(); err != nil {
+		t.Errorf("AvailablePhoneNumberParams.Validates returned error: %v", err)
+	}
+}
+
+func TestAvailablePhoneNumberService_Countries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("AvailablePhoneNumbers")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"countries": [{
+			"country_code": "US",
+			"country": "United States",
+			"beta": false,
+			"subresource_uris": {"local": "/local.json", "toll_free": "/tf.json"}
+		}]}`)
+	})
+
+	cl, _, err := client.AvailablePhoneNumbers.Countries()
+	if err != nil {
+		t.Errorf("AvailablePhoneNumber.Countries returned error: %v", err)
+	}
+
+	if len(cl) != 1 || cl[0].CountryCode != "US" {
+		t.Errorf("AvailablePhoneNumber.Countries returned %+v", cl)
+	}
+
+	want := []AvailableNumberType{AvailableLocal, AvailableTollFree}
+	if types := cl[0].AvailableNumberTypes(); !reflect.DeepEqual(types, want) {
+		t.Errorf("AvailableCountry.AvailableNumberTypes returned %v, want %v", types, want)
+	}
+}
+
+func TestAvailablePhoneNumberService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("AvailablePhoneNumbers", "US", "Local")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if q.Get("AreaCode") != "510" || q.Get("SmsEnabled") != "true" || q.Get("ExcludeAllAddressRequired") != "true" {
+			t.Errorf("Request query = %v", q)
+		}
+
+		fmt.Fprint(w, `{"available_phone_numbers": [{
+			"friendly_name": "(510) 564-7903",
+			"phone_number": "+15105647903",
+			"lata": "722",
+			"rate_center": "OKLD TRNID",
+			"latitude": "37.780000",
+			"longitude": "-122.380000",
+			"region": "CA",
+			"postal_code": "94703",
+			"iso_country": "US",
+			"address_requirements": "none",
+			"beta": false,
+			"capabilities": {"voice": true, "SMS": true, "MMS": true}
+		}]}`)
+	})
+
+	params := AvailablePhoneNumberParams{AreaCode: "510", SmsEnabled: Bool(true), ExcludeAllAddressRequired: Bool(true)}
+
+	nl, _, err := client.AvailablePhoneNumbers.Search("US", AvailableLocal, params)
+	if err != nil {
+		t.Errorf("AvailablePhoneNumber.Search returned error: %v", err)
+	}
+
+	want := []AvailablePhoneNumber{{
+		FriendlyName:        "(510) 564-7903",
+		PhoneNumber:         "+15105647903",
+		Lata:                "722",
+		RateCenter:          "OKLD TRNID",
+		Latitude:            37.78,
+		Longitude:           -122.38,
+		Region:              "CA",
+		PostalCode:          "94703",
+		IsoCountry:          "US",
+		AddressRequirements: "none",
+		Capabilities:        Capabilities{Voice: true, Sms: true, Mms: true},
+	}}
+
+	if !reflect.DeepEqual(nl, want) {
+		t.Errorf("AvailablePhoneNumber.Search returned %+v, want %+v", nl, want)
+	}
+}
+
+func TestAvailablePhoneNumberService_Search_badParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := client.AvailablePhoneNumbers.Search("usa", AvailableLocal, AvailablePhoneNumberParams{}); err == nil {
+		t.Error("Search with bad country expected an error to be returned")
+	}
+
+	if _, _, err := client.AvailablePhoneNumbers.Search("US", "Fixed", AvailablePhoneNumberParams{}); err == nil {
+		t.Error("Search with bad type expected an error to be returned")
+	}
+}
+
+func TestAvailablePhoneNumberService_SearchAndPurchase(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(client.EndPoint("AvailablePhoneNumbers", "GB", "Mobile").String(), func(w http.ResponseWriter, r *http.Request) {
+		if s := r.URL.Query().Get("PageSize"); s != "1" {
+			t.Errorf("Request PageSize = %q, want %q", s, "1")
+		}
+
+		fmt.Fprint(w, `{"available_phone_numbers": [{"phone_number": "+447700900123"}]}`)
+	})
+
+	mux.HandleFunc(client.EndPoint("IncomingPhoneNumbers").String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		fmt.Fprintf(w, `{"sid": "PN1234", "phone_number": %q}`, r.PostForm.Get("PhoneNumber"))
+	})
+
+	n, _, err := client.AvailablePhoneNumbers.SearchAndPurchase("GB", AvailableMobile, AvailablePhoneNumberParams{}, IncomingPhoneNumberParams{})
+	if err != nil {
+		t.Errorf("AvailablePhoneNumber.SearchAndPurchase returned error: %v", err)
+	}
+
+	want := &IncomingPhoneNumber{Sid: "PN1234", PhoneNumber: "+447700900123"}
+	if !reflect.DeepEqual(n, want) {
+		t.Errorf("AvailablePhoneNumber.SearchAndPurchase returned %+v, want %+v", n, want)
+	}
+}
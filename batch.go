@@ -0,0 +1,125 @@
+package twilio
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of messages of a batch sent at once when Batch.Concurrency isn't set.
+const DefaultBatchConcurrency = 4
+
+// Batch describes messages sent to many recipients. See MessageService.SendBatch.
+type Batch struct {
+	From string
+
+	// Params used for recipients which don't have their own.
+	Params MessageParams
+
+	Recipients []Recipient
+
+	// Maximum number of messages sent at once. Defaults to DefaultBatchConcurrency.
+	Concurrency int
+}
+
+// Recipient is a destination of a batch.
+type Recipient struct {
+	// May be blank when Params.To is set.
+	To string
+
+	// Params replacing Batch.Params for this recipient, eg: to personalize the body.
+	Params *MessageParams
+}
+
+// BatchResult is the outcome of sending to a recipient of a batch.
+type BatchResult struct {
+	// Position of the recipient in Batch.Recipients.
+	Index int
+	To    string
+
+	Message  *Message
+	Response *Response
+	Err      error
+}
+
+// SendBatch sends a message to every recipient of b, several at once, and returns the result of each recipient
+// in the order of b.Recipients. Set Client.RateLimiter to keep within the sender's rate, which applies
+// to every message of the batch:
+//
+//	c.RateLimiter = twilio.NewRateLimiter(1, 1)
+//	c.RateLimiter.Key = twilio.SenderKey
+//
+//	results := c.Messages.SendBatch(twilio.Batch{
+//		From:       "+14158141829",
+//		Params:     twilio.MessageParams{Body: "Hello"},
+//		Recipients: []twilio.Recipient{{To: "+15558675309"}, {To: "+15558675310"}},
+//	})
+func (s *MessageService) SendBatch(b Batch) []BatchResult {
+	return s.SendBatchContext(context.Background(), b)
+}
+
+// SendBatchContext is like SendBatch but bound to ctx. Recipients not sent yet when ctx is done get its error.
+func (s *MessageService) SendBatchContext(ctx context.Context, b Batch) []BatchResult {
+	results := make([]BatchResult, len(b.Recipients))
+	for r := range s.StreamBatch(ctx, b) {
+		results[r.Index] = r
+	}
+
+	return results
+}
+
+// StreamBatch is like SendBatchContext, but results are delivered on the returned channel as soon as each message
+// is sent. The channel is closed once every recipient has a result, it must be drained.
+func (s *MessageService) StreamBatch(ctx context.Context, b Batch) <-chan BatchResult {
+	n := b.Concurrency
+	if n < 1 {
+		n = DefaultBatchConcurrency
+	}
+
+	jobs := make(chan int)
+	results := make(chan BatchResult, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results <- s.sendRecipient(ctx, b, j)
+			}
+		}()
+	}
+
+	go func() {
+		for i := range b.Recipients {
+			jobs <- i
+		}
+		close(jobs)
+
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (s *MessageService) sendRecipient(ctx context.Context, b Batch, i int) BatchResult {
+	rcpt := b.Recipients[i]
+
+	params := b.Params
+	if rcpt.Params != nil {
+		params = *rcpt.Params
+	}
+
+	r := BatchResult{Index: i, To: rcpt.To}
+	if r.To == "" {
+		r.To = string(params.To)
+	}
+
+	if err := ctx.Err(); err != nil {
+		r.Err = err
+		return r
+	}
+
+	r.Message, r.Response, r.Err = s.SendContext(ctx, b.From, rcpt.To, params)
+	return r
+}
@@ -0,0 +1,119 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMessageService_SendBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	var (
+		mu       sync.Mutex
+		inflight int
+		peak     int
+	)
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		mu.Lock()
+		inflight++
+		if inflight > peak {
+			peak = inflight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inflight--
+		mu.Unlock()
+
+		r.ParseForm()
+		fmt.Fprintf(w, `{"sid": "SM%s", "to": %q, "body": %q}`, r.PostForm.Get("To")[1:], r.PostForm.Get("To"), r.PostForm.Get("Body"))
+	})
+
+	b := Batch{
+		From:   "+14158141829",
+		Params: MessageParams{Body: "Hello"},
+		Recipients: []Recipient{
+			{To: "+1001"},
+			{To: "+1002", Params: &MessageParams{Body: "Hello Jane"}},
+			{To: "+1003", Params: &MessageParams{}},
+			{To: "+1004"},
+			{To: "+1005"},
+		},
+		Concurrency: 2,
+	}
+
+	results := client.Messages.SendBatch(b)
+
+	if len(results) != 5 {
+		t.Fatalf("Message.SendBatch returned %d results, want 5", len(results))
+	}
+
+	for i, r := range results {
+		if r.Index != i || r.To != b.Recipients[i].To {
+			t.Errorf("Message.SendBatch result %d = %+v, want recipient %s", i, r, b.Recipients[i].To)
+		}
+
+		if i == 2 {
+			if r.Err == nil {
+				t.Errorf("Message.SendBatch result %d expected an error to be returned", i)
+			}
+			continue
+		}
+
+		if r.Err != nil || r.Message.To != b.Recipients[i].To {
+			t.Errorf("Message.SendBatch result %d = %+v, %v", i, r.Message, r.Err)
+		}
+	}
+
+	if results[1].Message.Body != "Hello Jane" {
+		t.Errorf("Message.SendBatch sent %q, want recipient params", results[1].Message.Body)
+	}
+
+	if peak > 2 {
+		t.Errorf("Message.SendBatch sent %d messages at once, want at most 2", peak)
+	}
+}
+
+func TestMessageService_StreamBatch_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Message.StreamBatch sent a request after ctx was canceled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	b := Batch{
+		From:       "+14158141829",
+		Params:     MessageParams{Body: "Hello"},
+		Recipients: []Recipient{{To: "+1001"}, {To: "+1002"}, {To: "+1003"}},
+	}
+
+	n := 0
+	for r := range client.Messages.StreamBatch(ctx, b) {
+		n++
+		if r.Err != context.Canceled {
+			t.Errorf("Message.StreamBatch result %d error = %v, want %v", r.Index, r.Err, context.Canceled)
+		}
+	}
+
+	if n != 3 {
+		t.Errorf("Message.StreamBatch returned %d results, want 3", n)
+	}
+}
@@ -0,0 +1,306 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+type CallService struct {
+	client *Client
+}
+
+// CallStatus is the status of a call.
+type CallStatus string
+
+const (
+	CallStatusQueued     CallStatus = "queued"
+	CallStatusRinging    CallStatus = "ringing"
+	CallStatusInProgress CallStatus = "in-progress"
+	CallStatusCanceled   CallStatus = "canceled"
+	CallStatusCompleted  CallStatus = "completed"
+	CallStatusBusy       CallStatus = "busy"
+	CallStatusFailed     CallStatus = "failed"
+	CallStatusNoAnswer   CallStatus = "no-answer"
+)
+
+// IsFinal reports whether the call has ended.
+func (s CallStatus) IsFinal() bool {
+	switch s {
+	case CallStatusCanceled, CallStatusCompleted, CallStatusBusy, CallStatusFailed, CallStatusNoAnswer:
+		return true
+	}
+
+	return false
+}
+
+type Call struct {
+	Sid            string     `json:"sid"`
+	ParentCallSid  string     `json:"parent_call_sid"`
+	AccountSid     string     `json:"account_sid"`
+	ApiVersion     string     `json:"api_version"`
+	DateCreated    Timestamp  `json:"date_created,omitempty"`
+	DateUpdated    Timestamp  `json:"date_updated,omitempty"`
+	StartTime      Timestamp  `json:"start_time,omitempty"`
+	EndTime        Timestamp  `json:"end_time,omitempty"`
+	Duration       int        `json:"duration,string"`
+	Direction      string     `json:"direction"`
+	AnsweredBy     string     `json:"answered_by"`
+	From           string     `json:"from"`
+	FromFormatted  string     `json:"from_formatted"`
+	To             string     `json:"to"`
+	ToFormatted    string     `json:"to_formatted"`
+	ForwardedFrom  string     `json:"forwarded_from"`
+	CallerName     string     `json:"caller_name"`
+	PhoneNumberSid string     `json:"phone_number_sid"`
+	Price          Price      `json:"price,omitempty"`
+	PriceUnit      string     `json:"price_unit"`
+	Status         CallStatus `json:"status"`
+	Uri            string     `json:"uri"`
+}
+
+type CallParams struct {
+	// The URL of TwiML instructions to execute when the call connects. One of Url, Twiml or ApplicationSid is required.
+	Url string
+
+	// TwiML instructions to execute when the call connects, instead of fetching them from Url.
+	Twiml string
+
+	// Twilio will use the voice URL of this application.
+	ApplicationSid string
+
+	// HTTP method used to request Url, GET or POST.
+	Method      string
+	FallbackUrl string
+
+	// A URL that Twilio will request on the events listed in StatusCallbackEvent: initiated, ringing, answered and completed.
+	StatusCallback       string
+	StatusCallbackMethod string
+	StatusCallbackEvent  []string
+
+	// Either "Enable" or "DetectMessageEnd" to detect whether a human or a machine answered the call.
+	MachineDetection string
+
+	// Seconds to let the call ring before assuming there is no answer.
+	Timeout int
+
+	// Whether to record the call.
+	Record     bool
+	SendDigits string
+}
+
+func (p CallParams) Validates() error {
+	if p.Url == "" && p.Twiml == "" && p.ApplicationSid == "" {
+		return errors.New(`One of the "Url", "Twiml" or "ApplicationSid" is required.`)
+	}
+
+	if p.MachineDetection != "" && p.MachineDetection != "Enable" && p.MachineDetection != "DetectMessageEnd" {
+		return errors.New(`"MachineDetection" must be either "Enable" or "DetectMessageEnd".`)
+	}
+
+	if p.Timeout < 0 {
+		return errors.New(`"Timeout" must not be negative.`)
+	}
+
+	return nil
+}
+
+func (s *CallService) Create(v url.Values) (*Call, *Response, error) {
+	return s.CreateContext(context.Background(), v)
+}
+
+// CreateContext is like Create but bound to ctx.
+func (s *CallService) CreateContext(ctx context.Context, v url.Values) (*Call, *Response, error) {
+	u := s.client.EndPoint("Calls")
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	c := new(Call)
+	resp, err := s.client.DoContext(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+// Make places a call from a Twilio number to another phone number, Client identity or SIP address.
+//
+// One of these parameters is required:
+//
+//	Url            : The URL of TwiML instructions to execute when the call connects
+//	Twiml          : TwiML instructions to execute when the call connects
+//	ApplicationSid : Twilio will use the voice URL of this application
+func (s *CallService) Make(from, to string, params CallParams) (*Call, *Response, error) {
+	return s.MakeContext(context.Background(), from, to, params)
+}
+
+// MakeContext is like Make but bound to ctx.
+func (s *CallService) MakeContext(ctx context.Context, from, to string, params CallParams) (*Call, *Response, error) {
+	err := params.Validates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := structToUrlValues(&params)
+	v.Set("From", from)
+	v.Set("To", to)
+
+	return s.CreateContext(ctx, v)
+}
+
+func (s *CallService) Get(sid string) (*Call, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *CallService) GetContext(ctx context.Context, sid string) (*Call, *Response, error) {
+	u := s.client.EndPoint("Calls", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	c := new(Call)
+	resp, err := s.client.DoContext(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+// Update modifies a live call, eg: redirecting it to new TwiML or ending it.
+func (s *CallService) Update(sid string, v url.Values) (*Call, *Response, error) {
+	return s.UpdateContext(context.Background(), sid, v)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *CallService) UpdateContext(ctx context.Context, sid string, v url.Values) (*Call, *Response, error) {
+	u := s.client.EndPoint("Calls", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	c := new(Call)
+	resp, err := s.client.DoContext(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+// Redirect makes an in-progress call execute the TwiML at twimlUrl.
+func (s *CallService) Redirect(sid, twimlUrl string) (*Call, *Response, error) {
+	return s.Update(sid, url.Values{"Url": {twimlUrl}})
+}
+
+// Cancel hangs up a call which is still queued or ringing.
+func (s *CallService) Cancel(sid string) (*Call, *Response, error) {
+	return s.Update(sid, url.Values{"Status": {string(CallStatusCanceled)}})
+}
+
+// Complete hangs up a call in progress.
+func (s *CallService) Complete(sid string) (*Call, *Response, error) {
+	return s.Update(sid, url.Values{"Status": {string(CallStatusCompleted)}})
+}
+
+// Delete removes the call record from the account.
+func (s *CallService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *CallService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint("Calls", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
+type CallListParams struct {
+	To            string
+	From          string
+	ParentCallSid string
+	Status        CallStatus
+
+	// Dates are formatted as YYYY-MM-DD.
+	StartTime       string
+	StartTimeAfter  string `form:"StartTime>"`
+	StartTimeBefore string `form:"StartTime<"`
+	EndTime         string
+	EndTimeAfter    string `form:"EndTime>"`
+	EndTimeBefore   string `form:"EndTime<"`
+
+	PageSize int
+}
+
+func (s *CallService) List(params CallListParams) ([]Call, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *CallService) ListContext(ctx context.Context, params CallListParams) ([]Call, *Response, error) {
+	u := s.client.EndPoint("Calls")
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	l := new(callList)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Calls, resp, err
+}
+
+type callList struct {
+	Pagination
+	Calls []Call `json:"calls"`
+}
+
+// ListIter returns an iterator over all calls matching params. See MessageService.ListIter.
+func (s *CallService) ListIter(params CallListParams) *CallIter {
+	return s.ListIterContext(context.Background(), params)
+}
+
+// ListIterContext is like ListIter but bound to ctx.
+func (s *CallService) ListIterContext(ctx context.Context, params CallListParams) *CallIter {
+	u := s.client.EndPoint("Calls")
+	setQuery(u, structToUrlValues(&params))
+
+	return &CallIter{Pager: newPager(ctx, s.client, u.String())}
+}
+
+// CallIter iterates over calls across pages.
+type CallIter struct {
+	*Pager
+	page []Call
+	cur  Call
+}
+
+// Next advances to the next call, fetching the next page when needed.
+func (it *CallIter) Next() bool {
+	for len(it.page) == 0 {
+		l := new(callList)
+		if !it.nextPage(l) {
+			return false
+		}
+		it.page = l.Calls
+	}
+
+	if !it.take() {
+		return false
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Call returns the current call.
+func (it *CallIter) Call() Call {
+	return it.cur
+}
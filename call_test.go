@@ -0,0 +1,240 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCallStatus_IsFinal(t *testing.T) {
+	if !CallStatusCompleted.IsFinal() {
+		t.Error("CallStatus.IsFinal() should be true")
+	}
+
+	if CallStatusInProgress.IsFinal() {
+		t.Error("CallStatus.IsFinal() should be false")
+	}
+}
+
+func TestCallParams_Validates(t *testing.T) {
+	for _, p := range []CallParams{
+		{},
+		{Url: "http://example.com/twiml", MachineDetection: "Maybe"},
+		{Url: "http://example.com/twiml", Timeout: -1},
+	} {
+		if err := p.Validates(); err == nil {
+			t.Errorf("CallParams(%+v).Validates expected an error to be returned", p)
+		}
+	}
+}
+
+func TestCallService_Make(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls")
+
+	output := `{
+		"sid": "CA1234",
+		"status": "queued",
+		"duration": null,
+		"price": null,
+		"start_time": null
+	}`
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		want := map[string][]string{
+			"From":                {"+14158141829"},
+			"To":                  {"+15558675309"},
+			"Twiml":               {"<Response><Say>Hi</Say></Response>"},
+			"StatusCallback":      {"http://example.com/status"},
+			"StatusCallbackEvent": {"initiated", "completed"},
+			"MachineDetection":    {"Enable"},
+			"Timeout":             {"30"},
+			"Record":              {"true"},
+		}
+
+		if !reflect.DeepEqual(map[string][]string(r.PostForm), want) {
+			t.Errorf("Request form = %v, want %v", r.PostForm, want)
+		}
+
+		fmt.Fprint(w, output)
+	})
+
+	params := CallParams{
+		Twiml:               "<Response><Say>Hi</Say></Response>",
+		StatusCallback:      "http://example.com/status",
+		StatusCallbackEvent: []string{"initiated", "completed"},
+		MachineDetection:    "Enable",
+		Timeout:             30,
+		Record:              true,
+	}
+
+	c, _, err := client.Calls.Make("+14158141829", "+15558675309", params)
+	if err != nil {
+		t.Errorf("Call.Make returned error: %v", err)
+	}
+
+	want := &Call{Sid: "CA1234", Status: CallStatusQueued}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("Call.Make returned %+v, want %+v", c, want)
+	}
+}
+
+func TestCallService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls", "CA1234")
+
+	output := `{
+		"sid": "CA1234",
+		"parent_call_sid": "CA0000",
+		"account_sid": "AC5ef87",
+		"from": "+14158141829",
+		"to": "+15558675309",
+		"status": "completed",
+		"start_time": "Wed, 18 Aug 2010 20:01:40 +0000",
+		"end_time": "Wed, 18 Aug 2010 20:01:40 +0000",
+		"duration": "15",
+		"price": "-0.03000",
+		"price_unit": "USD",
+		"direction": "outbound-api",
+		"answered_by": "human"
+	}`
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, output)
+	})
+
+	c, _, err := client.Calls.Get("CA1234")
+	if err != nil {
+		t.Errorf("Call.Get returned error: %v", err)
+	}
+
+	tm := parseTimestamp("Wed, 18 Aug 2010 20:01:40 +0000")
+	want := &Call{
+		Sid:           "CA1234",
+		ParentCallSid: "CA0000",
+		AccountSid:    "AC5ef87",
+		From:          "+14158141829",
+		To:            "+15558675309",
+		Status:        CallStatusCompleted,
+		StartTime:     tm,
+		EndTime:       tm,
+		Duration:      15,
+		Price:         -0.03,
+		PriceUnit:     "USD",
+		Direction:     "outbound-api",
+		AnsweredBy:    "human",
+	}
+
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("Call.Get returned %+v, want %+v", c, want)
+	}
+}
+
+func TestCallService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if q.Get("Status") != "completed" || q.Get("StartTime>") != "2014-01-01" || q.Get("ParentCallSid") != "CA0000" {
+			t.Errorf("Request query = %v", q)
+		}
+
+		if _, ok := q["To"]; ok {
+			t.Error("Blank params should not be sent")
+		}
+
+		fmt.Fprint(w, `{"page": 0, "calls": [{"sid": "CA1234"}]}`)
+	})
+
+	params := CallListParams{
+		Status:         CallStatusCompleted,
+		StartTimeAfter: "2014-01-01",
+		ParentCallSid:  "CA0000",
+	}
+
+	cl, _, err := client.Calls.List(params)
+	if err != nil {
+		t.Errorf("Call.List returned error: %v", err)
+	}
+
+	want := []Call{{Sid: "CA1234"}}
+	if !reflect.DeepEqual(cl, want) {
+		t.Errorf("Call.List returned %+v, want %+v", cl, want)
+	}
+
+	it := client.Calls.ListIter(params)
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if n != 1 || it.Err() != nil {
+		t.Errorf("CallIter returned %d calls, err %v", n, it.Err())
+	}
+}
+
+func TestCallService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls", "CA1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		fmt.Fprintf(w, `{"sid": "CA1234", "status": "%s"}`, r.PostForm.Get("Status"))
+	})
+
+	c, _, err := client.Calls.Complete("CA1234")
+	if err != nil {
+		t.Errorf("Call.Complete returned error: %v", err)
+	}
+
+	if c.Status != CallStatusCompleted {
+		t.Errorf("Call.Complete returned status %q, want %q", c.Status, CallStatusCompleted)
+	}
+
+	c, _, err = client.Calls.Cancel("CA1234")
+	if err != nil {
+		t.Errorf("Call.Cancel returned error: %v", err)
+	}
+
+	if c.Status != CallStatusCanceled {
+		t.Errorf("Call.Cancel returned status %q, want %q", c.Status, CallStatusCanceled)
+	}
+}
+
+func TestCallService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls", "CA1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r, err := client.Calls.Delete("CA1234")
+	if err != nil {
+		t.Errorf("Call.Delete returned error: %v", err)
+	}
+
+	if r.StatusCode != http.StatusNoContent {
+		t.Errorf("Call.Delete status code = %d, want %d", r.StatusCode, http.StatusNoContent)
+	}
+}
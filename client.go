@@ -1,12 +1,14 @@
 package twilio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // A client manages communication with Twilio API
@@ -24,8 +26,22 @@ type Client struct {
 	AccountSid string
 	AuthToken  string
 
+	// Retry policy for requests answered with 429 or 5xx responses. Nil disables retrying.
+	RetryPolicy *RetryPolicy
+
+	// Client-side limiter throttling outbound requests. Nil disables limiting.
+	RateLimiter *RateLimiter
+
 	// Services used for communicating with different parts of the Twilio API
-	Messages *MessageService
+	Messages       *MessageService
+	Calls          *CallService
+	Recordings     *RecordingService
+	Transcriptions *TranscriptionService
+	Conferences    *ConferenceService
+	Queues         *QueueService
+
+	IncomingPhoneNumbers  *IncomingPhoneNumberService
+	AvailablePhoneNumbers *AvailablePhoneNumberService
 }
 
 // NewClient returns a new Twilio API client. This will load default http.Client if httpClient is nil.
@@ -45,6 +61,13 @@ func NewClient(accountSid, authToken string, httpClient *http.Client) *Client {
 	}
 
 	c.Messages = &MessageService{client: c}
+	c.Calls = &CallService{client: c}
+	c.Recordings = &RecordingService{client: c, parts: []string{"Recordings"}}
+	c.Transcriptions = &TranscriptionService{client: c, parts: []string{"Transcriptions"}}
+	c.Conferences = &ConferenceService{client: c}
+	c.Queues = &QueueService{client: c}
+	c.IncomingPhoneNumbers = &IncomingPhoneNumberService{client: c}
+	c.AvailablePhoneNumbers = &AvailablePhoneNumberService{client: c}
 
 	return c
 }
@@ -53,16 +76,26 @@ func NewClient(accountSid, authToken string, httpClient *http.Client) *Client {
 //
 //	c := NewClient("1234567", "token", nil)
 //	c.EndPoint("Messages", "abcdef") // "/2010-04-01/Accounts/1234567/Messages/abcdef.json"
-//
 func (c *Client) EndPoint(parts ...string) *url.URL {
+	return c.endPointWithFormat(apiFormat, parts...)
+}
+
+// endPointWithFormat is like EndPoint but with another extension than json, eg: for downloading recordings as mp3.
+func (c *Client) endPointWithFormat(format string, parts ...string) *url.URL {
 	up := []string{apiVersion, "Accounts", c.AccountSid}
 	up = append(up, parts...)
 	u, _ := url.Parse(strings.Join(up, "/"))
-	u.Path = fmt.Sprintf("/%s.%s", u.Path, apiFormat)
+	u.Path = fmt.Sprintf("/%s.%s", u.Path, format)
 	return u
 }
 
+// NewRequest creates an API request. It's a shortcut for NewRequestWithContext using context.Background().
 func (c *Client) NewRequest(method, urlStr string, body io.Reader) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request bound to ctx. A relative urlStr is resolved against BaseURL.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
 	ul, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -70,7 +103,10 @@ func (c *Client) NewRequest(method, urlStr string, body io.Reader) (*http.Reques
 
 	u := c.BaseURL.ResolveReference(ul)
 
-	req, _ := http.NewRequest(method, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
 	if method == "POST" || method == "PUT" {
 		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
@@ -85,24 +121,93 @@ func (c *Client) NewRequest(method, urlStr string, body io.Reader) (*http.Reques
 	return req, nil
 }
 
+// Do sends an API request using the context attached to req.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	return c.DoContext(req.Context(), req, v)
+}
+
+// DoContext sends an API request bound to ctx and decodes the JSON response into v.
+// When ctx is canceled or its deadline is exceeded, the returned error is ctx.Err(),
+// so it can be compared against context.Canceled or context.DeadlineExceeded.
+// Requests are throttled by RateLimiter and failed requests are retried according to RetryPolicy.
+func (c *Client) DoContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	response, err := c.send(ctx, req.WithContext(ctx))
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-
-	response := NewResponse(resp)
+	defer response.Body.Close()
 
-	err = CheckResponse(resp)
+	err = CheckResponse(response.Response)
 	if err != nil {
 		return response, err
 	}
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
+	// 204 No Content has nothing to decode, eg: on DELETE
+	if v != nil && response.StatusCode != http.StatusNoContent {
+		err = json.NewDecoder(response.Body).Decode(v)
 	}
 
 	return response, err
 }
+
+// DoStream sends an API request bound to ctx and returns the response with its body left open, for downloading
+// media. The caller must close the body. Error responses are checked and closed as in DoContext.
+func (c *Client) DoStream(ctx context.Context, req *http.Request) (*Response, error) {
+	response, err := c.send(ctx, req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, err
+	}
+
+	if err = CheckResponse(response.Response); err != nil {
+		response.Body.Close()
+		return response, err
+	}
+
+	return response, nil
+}
+
+// send performs req, waiting for RateLimiter before every attempt and retrying as long as RetryPolicy allows.
+// It returns the last response along with the number of attempts made and the time spent waiting for the limiter.
+func (c *Client) send(ctx context.Context, req *http.Request) (*Response, error) {
+	var waited time.Duration
+
+	for attempt := 1; ; attempt++ {
+		d, err := c.RateLimiter.wait(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		waited += d
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !c.RetryPolicy.shouldRetry(req, resp, attempt) {
+			response := NewResponse(resp)
+			response.Attempts = attempt
+			response.RateLimitWait = waited
+			return response, nil
+		}
+
+		d = c.RetryPolicy.delay(resp, attempt)
+		discardResponse(resp)
+
+		if err = sleepContext(ctx, d); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
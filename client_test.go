@@ -1,11 +1,13 @@
 package twilio
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -64,6 +66,77 @@ func TestDo(t *testing.T) {
 	assert.Equal(t, body, want)
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	c := NewClient(accountSid, authToken, nil)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "bar")
+
+	req, err := c.NewRequestWithContext(ctx, "GET", "/foo", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, req.Context().Value(key{}), "bar")
+	assert.Equal(t, req.Header.Get("Authorization"), encodeAuth())
+}
+
+func TestDoContext_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.DoContext(ctx, req, nil)
+	assert.Equal(t, err, context.Canceled)
+}
+
+func TestDoContext_deadlineExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	_, err := client.Do(req, nil)
+	assert.Equal(t, err, context.DeadlineExceeded)
+}
+
+func TestDo_noContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	type foo struct {
+		Bar string
+	}
+
+	req, _ := client.NewRequest("DELETE", "/", nil)
+	body := new(foo)
+	resp, err := client.Do(req, body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, resp.StatusCode, http.StatusNoContent)
+	assert.Equal(t, body, &foo{})
+}
+
 func TestDo_httpError(t *testing.T) {
 	setup()
 	defer teardown()
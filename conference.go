@@ -0,0 +1,125 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+type ConferenceService struct {
+	client *Client
+}
+
+// ConferenceStatus is the status of a conference.
+type ConferenceStatus string
+
+const (
+	ConferenceStatusInit       ConferenceStatus = "init"
+	ConferenceStatusInProgress ConferenceStatus = "in-progress"
+	ConferenceStatusCompleted  ConferenceStatus = "completed"
+)
+
+type Conference struct {
+	Sid                     string           `json:"sid"`
+	AccountSid              string           `json:"account_sid"`
+	FriendlyName            string           `json:"friendly_name"`
+	Status                  ConferenceStatus `json:"status"`
+	Region                  string           `json:"region"`
+	ReasonConferenceEnded   string           `json:"reason_conference_ended"`
+	CallSidEndingConference string           `json:"call_sid_ending_conference"`
+	ApiVersion              string           `json:"api_version"`
+	DateCreated             Timestamp        `json:"date_created,omitempty"`
+	DateUpdated             Timestamp        `json:"date_updated,omitempty"`
+	Uri                     string           `json:"uri"`
+}
+
+func (s *ConferenceService) Get(sid string) (*Conference, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *ConferenceService) GetContext(ctx context.Context, sid string) (*Conference, *Response, error) {
+	u := s.client.EndPoint("Conferences", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	c := new(Conference)
+	resp, err := s.client.DoContext(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+type ConferenceListParams struct {
+	FriendlyName string
+	Status       ConferenceStatus
+
+	// Dates are formatted as YYYY-MM-DD.
+	DateCreated       string
+	DateCreatedAfter  string `form:"DateCreated>"`
+	DateCreatedBefore string `form:"DateCreated<"`
+	DateUpdated       string
+	DateUpdatedAfter  string `form:"DateUpdated>"`
+	DateUpdatedBefore string `form:"DateUpdated<"`
+
+	PageSize int
+}
+
+func (s *ConferenceService) List(params ConferenceListParams) ([]Conference, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *ConferenceService) ListContext(ctx context.Context, params ConferenceListParams) ([]Conference, *Response, error) {
+	u := s.client.EndPoint("Conferences")
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		Conferences []Conference `json:"conferences"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Conferences, resp, err
+}
+
+// Update modifies a conference in progress, eg: ending it or playing an announcement to all participants.
+func (s *ConferenceService) Update(sid string, v url.Values) (*Conference, *Response, error) {
+	return s.UpdateContext(context.Background(), sid, v)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *ConferenceService) UpdateContext(ctx context.Context, sid string, v url.Values) (*Conference, *Response, error) {
+	u := s.client.EndPoint("Conferences", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	c := new(Conference)
+	resp, err := s.client.DoContext(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+// End ends the conference, disconnecting all participants.
+func (s *ConferenceService) End(sid string) (*Conference, *Response, error) {
+	return s.Update(sid, url.Values{"Status": {string(ConferenceStatusCompleted)}})
+}
+
+// Announce plays the TwiML or audio file at announceUrl to all participants.
+func (s *ConferenceService) Announce(sid, announceUrl string) (*Conference, *Response, error) {
+	return s.Update(sid, url.Values{"AnnounceUrl": {announceUrl}})
+}
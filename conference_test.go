@@ -0,0 +1,101 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestConferenceService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences", "CF1234")
+
+	output := `{
+		"sid": "CF1234",
+		"friendly_name": "support",
+		"status": "in-progress",
+		"region": "us1",
+		"date_created": "Wed, 18 Aug 2010 20:01:40 +0000"
+	}`
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, output)
+	})
+
+	c, _, err := client.Conferences.Get("CF1234")
+	if err != nil {
+		t.Errorf("Conference.Get returned error: %v", err)
+	}
+
+	want := &Conference{
+		Sid:          "CF1234",
+		FriendlyName: "support",
+		Status:       ConferenceStatusInProgress,
+		Region:       "us1",
+		DateCreated:  parseTimestamp("Wed, 18 Aug 2010 20:01:40 +0000"),
+	}
+
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("Conference.Get returned %+v, want %+v", c, want)
+	}
+}
+
+func TestConferenceService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if q.Get("FriendlyName") != "support" || q.Get("Status") != "in-progress" || q.Get("DateCreated<") != "2014-01-01" {
+			t.Errorf("Request query = %v", q)
+		}
+
+		fmt.Fprint(w, `{"page": 0, "conferences": [{"sid": "CF1234"}]}`)
+	})
+
+	params := ConferenceListParams{
+		FriendlyName:      "support",
+		Status:            ConferenceStatusInProgress,
+		DateCreatedBefore: "2014-01-01",
+	}
+
+	cl, _, err := client.Conferences.List(params)
+	if err != nil {
+		t.Errorf("Conference.List returned error: %v", err)
+	}
+
+	want := []Conference{{Sid: "CF1234"}}
+	if !reflect.DeepEqual(cl, want) {
+		t.Errorf("Conference.List returned %+v, want %+v", cl, want)
+	}
+}
+
+func TestConferenceService_End(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences", "CF1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		fmt.Fprintf(w, `{"sid": "CF1234", "status": "%s"}`, r.PostForm.Get("Status"))
+	})
+
+	c, _, err := client.Conferences.End("CF1234")
+	if err != nil {
+		t.Errorf("Conference.End returned error: %v", err)
+	}
+
+	if c.Status != ConferenceStatusCompleted {
+		t.Errorf("Conference.End returned status %q, want %q", c.Status, ConferenceStatusCompleted)
+	}
+}
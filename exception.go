@@ -1,7 +1,9 @@
 package twilio
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // Exception holds information about error response returned by Twilio API
@@ -10,9 +12,114 @@ type Exception struct {
 	Message  string `json:"message"`
 	Code     int    `json:"code"`
 	MoreInfo string `json:"more_info"`
+
+	// Raw response body, kept when it couldn't be decoded as JSON.
+	Body string `json:"-"`
 }
 
 // Exception implements Error interface
 func (e *Exception) Error() string {
 	return fmt.Sprintf("%d: %s", e.Code, e.Message)
 }
+
+// Is reports whether the exception matches target, so it can be checked against ErrorCode sentinels:
+//
+//	if errors.Is(err, twilio.ErrUnsubscribedRecipient) {
+//		// stop messaging this recipient
+//	}
+func (e *Exception) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.Code == int(code)
+}
+
+// ErrorCode is a Twilio error code. Well-known codes are exposed as sentinel errors, to be used with errors.Is.
+// See https://www.twilio.com/docs/api/errors for the complete list.
+type ErrorCode int
+
+const (
+	ErrAuthenticationFailed  ErrorCode = 20003
+	ErrResourceNotFound      ErrorCode = 20404
+	ErrTooManyRequests       ErrorCode = 20429
+	ErrInternalServerError   ErrorCode = 20500
+	ErrServiceUnavailable    ErrorCode = 20503
+	ErrToRequired            ErrorCode = 21201
+	ErrInvalidToNumber       ErrorCode = 21211
+	ErrInvalidFromNumber     ErrorCode = 21212
+	ErrRegionNotEnabled      ErrorCode = 21408
+	ErrMessageBodyRequired   ErrorCode = 21602
+	ErrFromRequired          ErrorCode = 21603
+	ErrFromNotSMSCapable     ErrorCode = 21606
+	ErrUnsubscribedRecipient ErrorCode = 21610
+	ErrNotMobileNumber       ErrorCode = 21614
+	ErrMessageBodyTooLong    ErrorCode = 21617
+	ErrQueueOverflow         ErrorCode = 30001
+	ErrAccountSuspended      ErrorCode = 30002
+	ErrUnreachable           ErrorCode = 30003
+	ErrMessageBlocked        ErrorCode = 30004
+	ErrUnknownDestination    ErrorCode = 30005
+	ErrLandlineOrUnreachable ErrorCode = 30006
+	ErrCarrierViolation      ErrorCode = 30007
+	ErrUnknownError          ErrorCode = 30008
+)
+
+var errorCodeMessages = map[ErrorCode]string{
+	ErrAuthenticationFailed:  "Authentication Error - invalid username",
+	ErrResourceNotFound:      "The requested resource was not found",
+	ErrTooManyRequests:       "Too Many Requests",
+	ErrInternalServerError:   "Internal Server Error",
+	ErrServiceUnavailable:    "Service Unavailable",
+	ErrToRequired:            "No 'To' number is specified",
+	ErrInvalidToNumber:       "Invalid 'To' Phone Number",
+	ErrInvalidFromNumber:     "Invalid 'From' Phone Number",
+	ErrRegionNotEnabled:      "Permission to send an SMS has not been enabled for the region indicated by the 'To' number",
+	ErrMessageBodyRequired:   "Message body is required",
+	ErrFromRequired:          "A 'From' phone number is required",
+	ErrFromNotSMSCapable:     "The 'From' phone number provided is not a valid, message-capable Twilio phone number",
+	ErrUnsubscribedRecipient: "Attempt to send to unsubscribed recipient",
+	ErrNotMobileNumber:       "'To' number is not a valid mobile number",
+	ErrMessageBodyTooLong:    "The concatenated message body exceeds the 1600 character limit",
+	ErrQueueOverflow:         "Queue overflow",
+	ErrAccountSuspended:      "Account suspended",
+	ErrUnreachable:           "Unreachable destination handset",
+	ErrMessageBlocked:        "Message blocked",
+	ErrUnknownDestination:    "Unknown destination handset",
+	ErrLandlineOrUnreachable: "Landline or unreachable carrier",
+	ErrCarrierViolation:      "Carrier violation",
+	ErrUnknownError:          "Unknown error",
+}
+
+// ErrorCode implements Error interface
+func (c ErrorCode) Error() string {
+	if msg, ok := errorCodeMessages[c]; ok {
+		return fmt.Sprintf("%d: %s", c, msg)
+	}
+
+	return fmt.Sprintf("%d: Twilio error", c)
+}
+
+// IsRetryable reports whether err is a Twilio error which may succeed when the request is sent again later,
+// such as rate limiting, queue overflow or server errors.
+func IsRetryable(err error) bool {
+	var e *Exception
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	switch ErrorCode(e.Code) {
+	case ErrTooManyRequests, ErrInternalServerError, ErrServiceUnavailable, ErrQueueOverflow:
+		return true
+	}
+
+	return isRetryableStatus(e.Status)
+}
+
+// IsPermanent reports whether err is a Twilio error which will fail again when the request is repeated unchanged,
+// eg: an invalid or unsubscribed recipient.
+func IsPermanent(err error) bool {
+	var e *Exception
+	if !errors.As(err, &e) || IsRetryable(err) {
+		return false
+	}
+
+	return e.Code != 0 || (http.StatusBadRequest <= e.Status && e.Status <= 499)
+}
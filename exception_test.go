@@ -2,6 +2,8 @@ package twilio
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -39,3 +41,32 @@ func TestException_Error(t *testing.T) {
 	want := "21201: No to number is specified"
 	assert.Equal(t, ex.Error(), want)
 }
+
+func TestException_Is(t *testing.T) {
+	var err error = &Exception{Status: 400, Code: 21610, Message: "Attempt to send to unsubscribed recipient"}
+
+	assert.True(t, errors.Is(err, ErrUnsubscribedRecipient))
+	assert.False(t, errors.Is(err, ErrInvalidToNumber))
+	assert.True(t, errors.Is(fmt.Errorf("send: %w", err), ErrUnsubscribedRecipient))
+}
+
+func TestErrorCode_Error(t *testing.T) {
+	assert.Equal(t, ErrInvalidToNumber.Error(), "21211: Invalid 'To' Phone Number")
+	assert.Equal(t, ErrorCode(99999).Error(), "99999: Twilio error")
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(&Exception{Status: 429, Code: 20429}))
+	assert.True(t, IsRetryable(&Exception{Status: 503}))
+	assert.True(t, IsRetryable(&Exception{Status: 400, Code: 30001}))
+	assert.False(t, IsRetryable(&Exception{Status: 400, Code: 21211}))
+	assert.False(t, IsRetryable(errors.New("foo")))
+}
+
+func TestIsPermanent(t *testing.T) {
+	assert.True(t, IsPermanent(&Exception{Status: 400, Code: 21211}))
+	assert.True(t, IsPermanent(&Exception{Status: 404}))
+	assert.False(t, IsPermanent(&Exception{Status: 429, Code: 20429}))
+	assert.False(t, IsPermanent(&Exception{Status: 502}))
+	assert.False(t, IsPermanent(errors.New("foo")))
+}
@@ -0,0 +1,65 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// FeedbackOutcome tells whether the recipient acted on a message, eg: entered the code it contained.
+type FeedbackOutcome string
+
+const (
+	FeedbackConfirmed   FeedbackOutcome = "confirmed"
+	FeedbackUnconfirmed FeedbackOutcome = "unconfirmed"
+)
+
+// MessageFeedback reports the delivery outcome of a message sent with "ProvideFeedback".
+type MessageFeedback struct {
+	AccountSid  string          `json:"account_sid"`
+	MessageSid  string          `json:"message_sid"`
+	Outcome     FeedbackOutcome `json:"outcome"`
+	DateCreated Timestamp       `json:"date_created,omitempty"`
+	DateUpdated Timestamp       `json:"date_updated,omitempty"`
+	Uri         string          `json:"uri"`
+}
+
+// Feedback reports the outcome of the message sid, which must have been sent with "ProvideFeedback".
+func (s *MessageService) Feedback(sid string, outcome FeedbackOutcome) (*MessageFeedback, *Response, error) {
+	return s.FeedbackContext(context.Background(), sid, outcome)
+}
+
+// FeedbackContext is like Feedback but bound to ctx.
+func (s *MessageService) FeedbackContext(ctx context.Context, sid string, outcome FeedbackOutcome) (*MessageFeedback, *Response, error) {
+	if outcome != FeedbackConfirmed && outcome != FeedbackUnconfirmed {
+		return nil, nil, errors.New(`"Outcome" must be either "confirmed" or "unconfirmed".`)
+	}
+
+	u := s.client.EndPoint("Messages", sid, "Feedback")
+	v := url.Values{"Outcome": {string(outcome)}}
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	f := new(MessageFeedback)
+	resp, err := s.client.DoContext(ctx, req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f, resp, err
+}
+
+// Confirm reports the message sid as received, eg: once the user entered the one-time code it contained.
+//
+//	m, _, err := c.Messages.Send(from, to, twilio.MessageParams{Body: "Your code is 1234", ProvideFeedback: true})
+//	// later, when the user entered the code
+//	_, _, err = c.Messages.Confirm(m.Sid)
+func (s *MessageService) Confirm(sid string) (*MessageFeedback, *Response, error) {
+	return s.FeedbackContext(context.Background(), sid, FeedbackConfirmed)
+}
+
+// ConfirmContext is like Confirm but bound to ctx.
+func (s *MessageService) ConfirmContext(ctx context.Context, sid string) (*MessageFeedback, *Response, error) {
+	return s.FeedbackContext(ctx, sid, FeedbackConfirmed)
+}
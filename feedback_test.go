@@ -0,0 +1,41 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMessageService_Confirm(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "SM1234", "Feedback")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		fmt.Fprintf(w, `{"message_sid": "SM1234", "outcome": %q}`, r.PostForm.Get("Outcome"))
+	})
+
+	f, _, err := client.Messages.Confirm("SM1234")
+	if err != nil {
+		t.Errorf("Message.Confirm returned error: %v", err)
+	}
+
+	want := &MessageFeedback{MessageSid: "SM1234", Outcome: FeedbackConfirmed}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("Message.Confirm returned %+v, want %+v", f, want)
+	}
+}
+
+func TestMessageService_Feedback_invalidOutcome(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Messages.Feedback("SM1234", "delivered")
+	if err == nil {
+		t.Error("Message.Feedback expected an error to be returned")
+	}
+}
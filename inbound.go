@@ -0,0 +1,127 @@
+package twilio
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+// MaxInboundMedia is the maximum number of media Twilio attaches to a message.
+const MaxInboundMedia = 10
+
+// InboundMessage is an SMS or MMS received by one of your Twilio numbers, as POSTed by Twilio to the number's SmsUrl.
+type InboundMessage struct {
+	Sid                 string
+	AccountSid          string
+	MessagingServiceSid string
+	ApiVersion          string
+	Body                string
+	NumSegments         int
+	NumMedia            int
+	From                string
+	To                  string
+
+	// Media attached to the message, in the order Twilio sent them.
+	Media []InboundMedia
+
+	// Geographic data of the sender and the recipient, when Twilio is able to look it up.
+	FromCity    string
+	FromState   string
+	FromZip     string
+	FromCountry string
+	ToCity      string
+	ToState     string
+	ToZip       string
+	ToCountry   string
+}
+
+// FromNumber returns the sender as PhoneNumber.
+func (m *InboundMessage) FromNumber() PhoneNumber {
+	return PhoneNumber(m.From)
+}
+
+// ToNumber returns the recipient as PhoneNumber.
+func (m *InboundMessage) ToNumber() PhoneNumber {
+	return PhoneNumber(m.To)
+}
+
+// InboundMedia is a media attached to an InboundMessage.
+type InboundMedia struct {
+	Url         string
+	ContentType string
+}
+
+// Sid returns the media sid, taken from its URL. Use it to download or delete the media through MessageService.Media.
+func (m InboundMedia) Sid() string {
+	return path.Base(m.Url)
+}
+
+// ParseInboundMessage parses the webhook request Twilio sends when a message is received.
+// Use WebhookValidator to ensure the request was actually sent by Twilio.
+func ParseInboundMessage(r *http.Request) (*InboundMessage, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	m := &InboundMessage{
+		Sid:                 f.Get("MessageSid"),
+		AccountSid:          f.Get("AccountSid"),
+		MessagingServiceSid: f.Get("MessagingServiceSid"),
+		ApiVersion:          f.Get("ApiVersion"),
+		Body:                f.Get("Body"),
+		From:                f.Get("From"),
+		To:                  f.Get("To"),
+		FromCity:            f.Get("FromCity"),
+		FromState:           f.Get("FromState"),
+		FromZip:             f.Get("FromZip"),
+		FromCountry:         f.Get("FromCountry"),
+		ToCity:              f.Get("ToCity"),
+		ToState:             f.Get("ToState"),
+		ToZip:               f.Get("ToZip"),
+		ToCountry:           f.Get("ToCountry"),
+	}
+
+	if m.Sid == "" {
+		m.Sid = f.Get("SmsSid")
+	}
+
+	if m.Sid == "" {
+		return nil, errors.New(`Inbound message requires "MessageSid".`)
+	}
+
+	var err error
+
+	if m.NumSegments, err = formInt(f.Get("NumSegments")); err != nil {
+		return nil, err
+	}
+
+	if m.NumMedia, err = formInt(f.Get("NumMedia")); err != nil {
+		return nil, err
+	}
+
+	if m.NumMedia < 0 || m.NumMedia > MaxInboundMedia {
+		return nil, errors.New(`Inbound message "NumMedia" must be between 0 and 10.`)
+	}
+
+	for i := 0; i < m.NumMedia; i++ {
+		n := strconv.Itoa(i)
+		m.Media = append(m.Media, InboundMedia{
+			Url:         f.Get("MediaUrl" + n),
+			ContentType: f.Get("MediaContentType" + n),
+		})
+	}
+
+	return m, nil
+}
+
+// formInt parses s as integer. Blank s is treated as zero.
+func formInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(s)
+}
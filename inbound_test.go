@@ -0,0 +1,93 @@
+package twilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newFormRequest(v url.Values) *http.Request {
+	r := httptest.NewRequest("POST", "http://example.com/sms", strings.NewReader(v.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestParseInboundMessage(t *testing.T) {
+	r := newFormRequest(url.Values{
+		"MessageSid":        {"MM90c6fc909d8504d45ecdb3a3d5b3556e"},
+		"AccountSid":        {"AC5ef87"},
+		"ApiVersion":        {"2010-04-01"},
+		"From":              {"+14158141829"},
+		"To":                {"+15558675309"},
+		"Body":              {"Hello"},
+		"NumSegments":       {"1"},
+		"NumMedia":          {"2"},
+		"MediaUrl0":         {"https://api.twilio.com/media/ME1"},
+		"MediaContentType0": {"image/jpeg"},
+		"MediaUrl1":         {"https://api.twilio.com/media/ME2"},
+		"MediaContentType1": {"image/png"},
+		"FromCity":          {"SAN FRANCISCO"},
+		"FromState":         {"CA"},
+		"FromZip":           {"94103"},
+		"FromCountry":       {"US"},
+		"ToCountry":         {"US"},
+	})
+
+	m, err := ParseInboundMessage(r)
+	if err != nil {
+		t.Errorf("ParseInboundMessage returned error: %v", err)
+	}
+
+	want := &InboundMessage{
+		Sid:         "MM90c6fc909d8504d45ecdb3a3d5b3556e",
+		AccountSid:  "AC5ef87",
+		ApiVersion:  "2010-04-01",
+		From:        "+14158141829",
+		To:          "+15558675309",
+		Body:        "Hello",
+		NumSegments: 1,
+		NumMedia:    2,
+		Media: []InboundMedia{
+			{Url: "https://api.twilio.com/media/ME1", ContentType: "image/jpeg"},
+			{Url: "https://api.twilio.com/media/ME2", ContentType: "image/png"},
+		},
+		FromCity:    "SAN FRANCISCO",
+		FromState:   "CA",
+		FromZip:     "94103",
+		FromCountry: "US",
+		ToCountry:   "US",
+	}
+
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("ParseInboundMessage returned %+v, want %+v", m, want)
+	}
+}
+
+func TestParseInboundMessage_missingSid(t *testing.T) {
+	_, err := ParseInboundMessage(newFormRequest(url.Values{"Body": {"Hello"}}))
+
+	if err == nil {
+		t.Error("ParseInboundMessage expected an error to be returned")
+	}
+}
+
+func TestParseInboundMessage_badNumMedia(t *testing.T) {
+	_, err := ParseInboundMessage(newFormRequest(url.Values{"MessageSid": {"MM1"}, "NumMedia": {"x"}}))
+
+	if err == nil {
+		t.Error("ParseInboundMessage expected an error to be returned")
+	}
+}
+
+func TestParseInboundMessage_numMediaOutOfRange(t *testing.T) {
+	for _, n := range []string{"-1", "11", "20000000"} {
+		_, err := ParseInboundMessage(newFormRequest(url.Values{"MessageSid": {"SM1"}, "NumMedia": {n}}))
+
+		if err == nil {
+			t.Errorf("ParseInboundMessage with NumMedia %s expected an error to be returned", n)
+		}
+	}
+}
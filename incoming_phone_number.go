@@ -0,0 +1,221 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+type IncomingPhoneNumberService struct {
+	client *Client
+}
+
+// Capabilities tells which kinds of communication a phone number supports.
+type Capabilities struct {
+	Voice bool `json:"voice"`
+	Sms   bool `json:"sms"`
+	Mms   bool `json:"mms"`
+	Fax   bool `json:"fax"`
+}
+
+type IncomingPhoneNumber struct {
+	Sid                  string       `json:"sid"`
+	AccountSid           string       `json:"account_sid"`
+	FriendlyName         string       `json:"friendly_name"`
+	PhoneNumber          string       `json:"phone_number"`
+	Capabilities         Capabilities `json:"capabilities"`
+	VoiceUrl             string       `json:"voice_url"`
+	VoiceMethod          string       `json:"voice_method"`
+	VoiceFallbackUrl     string       `json:"voice_fallback_url"`
+	VoiceFallbackMethod  string       `json:"voice_fallback_method"`
+	VoiceApplicationSid  string       `json:"voice_application_sid"`
+	VoiceCallerIdLookup  bool         `json:"voice_caller_id_lookup"`
+	StatusCallback       string       `json:"status_callback"`
+	StatusCallbackMethod string       `json:"status_callback_method"`
+	SmsUrl               string       `json:"sms_url"`
+	SmsMethod            string       `json:"sms_method"`
+	SmsFallbackUrl       string       `json:"sms_fallback_url"`
+	SmsFallbackMethod    string       `json:"sms_fallback_method"`
+	SmsApplicationSid    string       `json:"sms_application_sid"`
+	AddressRequirements  string       `json:"address_requirements"`
+	Beta                 bool         `json:"beta"`
+	Origin               string       `json:"origin"`
+	Status               string       `json:"status"`
+	ApiVersion           string       `json:"api_version"`
+	DateCreated          Timestamp    `json:"date_created,omitempty"`
+	DateUpdated          Timestamp    `json:"date_updated,omitempty"`
+	Uri                  string       `json:"uri"`
+}
+
+// IncomingPhoneNumberParams configures a phone number on purchase or update. Blank params are left unchanged.
+type IncomingPhoneNumberParams struct {
+	FriendlyName string
+
+	// The URL Twilio requests when the number receives a call, using VoiceMethod (GET or POST).
+	VoiceUrl            string
+	VoiceMethod         string
+	VoiceFallbackUrl    string
+	VoiceFallbackMethod string
+	VoiceApplicationSid string
+	VoiceCallerIdLookup *bool
+
+	// The URL Twilio requests when a call status changes.
+	StatusCallback       string
+	StatusCallbackMethod string
+
+	// The URL Twilio requests when the number receives a message, using SmsMethod (GET or POST).
+	SmsUrl            string
+	SmsMethod         string
+	SmsFallbackUrl    string
+	SmsFallbackMethod string
+	SmsApplicationSid string
+}
+
+func (p IncomingPhoneNumberParams) Validates() error {
+	for _, m := range []string{p.VoiceMethod, p.VoiceFallbackMethod, p.StatusCallbackMethod, p.SmsMethod, p.SmsFallbackMethod} {
+		if m != "" && m != "GET" && m != "POST" {
+			return errors.New(`HTTP methods must be either "GET" or "POST".`)
+		}
+	}
+
+	return nil
+}
+
+// Purchase buys phoneNumber, usually found with AvailablePhoneNumberService, and configures it with params.
+func (s *IncomingPhoneNumberService) Purchase(phoneNumber string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	return s.PurchaseContext(context.Background(), phoneNumber, params)
+}
+
+// PurchaseContext is like Purchase but bound to ctx.
+func (s *IncomingPhoneNumberService) PurchaseContext(ctx context.Context, phoneNumber string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	if phoneNumber == "" {
+		return nil, nil, errors.New(`"PhoneNumber" is required.`)
+	}
+
+	return s.purchase(ctx, "PhoneNumber", phoneNumber, params)
+}
+
+// PurchaseByAreaCode buys any available local number in areaCode (US and Canada only).
+func (s *IncomingPhoneNumberService) PurchaseByAreaCode(areaCode string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	return s.PurchaseByAreaCodeContext(context.Background(), areaCode, params)
+}
+
+// PurchaseByAreaCodeContext is like PurchaseByAreaCode but bound to ctx.
+func (s *IncomingPhoneNumberService) PurchaseByAreaCodeContext(ctx context.Context, areaCode string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	if areaCode == "" {
+		return nil, nil, errors.New(`"AreaCode" is required.`)
+	}
+
+	return s.purchase(ctx, "AreaCode", areaCode, params)
+}
+
+func (s *IncomingPhoneNumberService) purchase(ctx context.Context, key, value string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	err := params.Validates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := structToUrlValues(&params)
+	v.Set(key, value)
+
+	return s.post(ctx, s.client.EndPoint("IncomingPhoneNumbers"), v)
+}
+
+func (s *IncomingPhoneNumberService) Get(sid string) (*IncomingPhoneNumber, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *IncomingPhoneNumberService) GetContext(ctx context.Context, sid string) (*IncomingPhoneNumber, *Response, error) {
+	u := s.client.EndPoint("IncomingPhoneNumbers", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	n := new(IncomingPhoneNumber)
+	resp, err := s.client.DoContext(ctx, req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+func (s *IncomingPhoneNumberService) Update(sid string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	return s.UpdateContext(context.Background(), sid, params)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *IncomingPhoneNumberService) UpdateContext(ctx context.Context, sid string, params IncomingPhoneNumberParams) (*IncomingPhoneNumber, *Response, error) {
+	err := params.Validates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.post(ctx, s.client.EndPoint("IncomingPhoneNumbers", sid), structToUrlValues(&params))
+}
+
+func (s *IncomingPhoneNumberService) post(ctx context.Context, u *url.URL, v url.Values) (*IncomingPhoneNumber, *Response, error) {
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	n := new(IncomingPhoneNumber)
+	resp, err := s.client.DoContext(ctx, req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// Release removes the phone number from the account. It can't be undone.
+func (s *IncomingPhoneNumberService) Release(sid string) (*Response, error) {
+	return s.ReleaseContext(context.Background(), sid)
+}
+
+// ReleaseContext is like Release but bound to ctx.
+func (s *IncomingPhoneNumberService) ReleaseContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint("IncomingPhoneNumbers", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
+type IncomingPhoneNumberListParams struct {
+	// Matches numbers containing this pattern, eg: "555" or "+1415*".
+	PhoneNumber  string
+	FriendlyName string
+	Beta         *bool
+
+	// Either "twilio" or "hosted".
+	Origin string
+
+	PageSize int
+}
+
+func (s *IncomingPhoneNumberService) List(params IncomingPhoneNumberListParams) ([]IncomingPhoneNumber, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *IncomingPhoneNumberService) ListContext(ctx context.Context, params IncomingPhoneNumberListParams) ([]IncomingPhoneNumber, *Response, error) {
+	u := s.client.EndPoint("IncomingPhoneNumbers")
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		IncomingPhoneNumbers []IncomingPhoneNumber `json:"incoming_phone_numbers"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.IncomingPhoneNumbers, resp, err
+}
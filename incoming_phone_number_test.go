@@ -0,0 +1,154 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncomingPhoneNumberParams_Validates(t *testing.T) {
+	if err := (IncomingPhoneNumberParams{SmsMethod: "PUT"}).Validates(); err == nil {
+		t.Error("IncomingPhoneNumberParams.Validates expected an error to be returned")
+	}
+}
+
+func TestIncomingPhoneNumberService_Purchase(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("IncomingPhoneNumbers")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		want := map[string][]string{
+			"PhoneNumber": {"+15005550006"},
+			"SmsUrl":      {"http://example.com/sms"},
+			"SmsMethod":   {"POST"},
+		}
+
+		if !reflect.DeepEqual(map[string][]string(r.PostForm), want) {
+			t.Errorf("Request form = %v, want %v", r.PostForm, want)
+		}
+
+		fmt.Fprint(w, `{
+			"sid": "PN1234",
+			"phone_number": "+15005550006",
+			"sms_url": "http://example.com/sms",
+			"sms_method": "POST",
+			"capabilities": {"voice": true, "sms": true, "mms": false, "fax": false}
+		}`)
+	})
+
+	params := IncomingPhoneNumberParams{SmsUrl: "http://example.com/sms", SmsMethod: "POST"}
+
+	n, _, err := client.IncomingPhoneNumbers.Purchase("+15005550006", params)
+	if err != nil {
+		t.Errorf("IncomingPhoneNumber.Purchase returned error: %v", err)
+	}
+
+	want := &IncomingPhoneNumber{
+		Sid:          "PN1234",
+		PhoneNumber:  "+15005550006",
+		SmsUrl:       "http://example.com/sms",
+		SmsMethod:    "POST",
+		Capabilities: Capabilities{Voice: true, Sms: true},
+	}
+
+	if !reflect.DeepEqual(n, want) {
+		t.Errorf("IncomingPhoneNumber.Purchase returned %+v, want %+v", n, want)
+	}
+
+	if _, _, err := client.IncomingPhoneNumbers.PurchaseByAreaCode("", params); err == nil {
+		t.Error("IncomingPhoneNumber.PurchaseByAreaCode without area code expected an error to be returned")
+	}
+}
+
+func TestIncomingPhoneNumberService_PurchaseByAreaCode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("IncomingPhoneNumbers")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if a := r.PostForm.Get("AreaCode"); a != "415" {
+			t.Errorf("Request AreaCode = %q, want %q", a, "415")
+		}
+
+		fmt.Fprint(w, `{"sid": "PN1234", "phone_number": "+14155550006"}`)
+	})
+
+	n, _, err := client.IncomingPhoneNumbers.PurchaseByAreaCode("415", IncomingPhoneNumberParams{})
+	if err != nil || n.PhoneNumber != "+14155550006" {
+		t.Errorf("IncomingPhoneNumber.PurchaseByAreaCode returned %+v, %v", n, err)
+	}
+}
+
+func TestIncomingPhoneNumberService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("IncomingPhoneNumbers")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if q.Get("FriendlyName") != "customer-42" || q.Get("Beta") != "false" {
+			t.Errorf("Request query = %v", q)
+		}
+
+		fmt.Fprint(w, `{"page": 0, "incoming_phone_numbers": [{"sid": "PN1234"}]}`)
+	})
+
+	nl, _, err := client.IncomingPhoneNumbers.List(IncomingPhoneNumberListParams{FriendlyName: "customer-42", Beta: Bool(false)})
+	if err != nil {
+		t.Errorf("IncomingPhoneNumber.List returned error: %v", err)
+	}
+
+	want := []IncomingPhoneNumber{{Sid: "PN1234"}}
+	if !reflect.DeepEqual(nl, want) {
+		t.Errorf("IncomingPhoneNumber.List returned %+v, want %+v", nl, want)
+	}
+}
+
+func TestIncomingPhoneNumberService_GetUpdateRelease(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("IncomingPhoneNumbers", "PN1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"sid": "PN1234", "friendly_name": "main"}`)
+		case "POST":
+			r.ParseForm()
+			fmt.Fprintf(w, `{"sid": "PN1234", "friendly_name": %q, "sms_application_sid": %q}`, r.PostForm.Get("FriendlyName"), r.PostForm.Get("SmsApplicationSid"))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	n, _, err := client.IncomingPhoneNumbers.Get("PN1234")
+	if err != nil || n.FriendlyName != "main" {
+		t.Errorf("IncomingPhoneNumber.Get returned %+v, %v", n, err)
+	}
+
+	n, _, err = client.IncomingPhoneNumbers.Update("PN1234", IncomingPhoneNumberParams{FriendlyName: "support", SmsApplicationSid: "AP1234"})
+	if err != nil {
+		t.Errorf("IncomingPhoneNumber.Update returned error: %v", err)
+	}
+
+	want := &IncomingPhoneNumber{Sid: "PN1234", FriendlyName: "support", SmsApplicationSid: "AP1234"}
+	if !reflect.DeepEqual(n, want) {
+		t.Errorf("IncomingPhoneNumber.Update returned %+v, want %+v", n, want)
+	}
+
+	if _, err := client.IncomingPhoneNumbers.Release("PN1234"); err != nil {
+		t.Errorf("IncomingPhoneNumber.Release returned error: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package twilio
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// MediaService manages media attached to a message. See MessageService.Media.
+type MediaService struct {
+	client     *Client
+	messageSid string
+}
+
+type Media struct {
+	Sid         string    `json:"sid"`
+	AccountSid  string    `json:"account_sid"`
+	ParentSid   string    `json:"parent_sid"`
+	ContentType string    `json:"content_type"`
+	DateCreated Timestamp `json:"date_created,omitempty"`
+	DateUpdated Timestamp `json:"date_updated,omitempty"`
+	Uri         string    `json:"uri"`
+}
+
+// Media returns the service managing media of the message sid.
+func (s *MessageService) Media(sid string) *MediaService {
+	return &MediaService{client: s.client, messageSid: sid}
+}
+
+func (s *MediaService) endPoint(parts ...string) *url.URL {
+	return s.client.EndPoint(append([]string{"Messages", s.messageSid, "Media"}, parts...)...)
+}
+
+func (s *MediaService) Get(sid string) (*Media, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *MediaService) GetContext(ctx context.Context, sid string) (*Media, *Response, error) {
+	u := s.endPoint(sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	m := new(Media)
+	resp, err := s.client.DoContext(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+type MediaListParams struct {
+	// Dates are formatted as YYYY-MM-DD.
+	DateCreated       string
+	DateCreatedAfter  string `form:"DateCreated>"`
+	DateCreatedBefore string `form:"DateCreated<"`
+
+	PageSize int
+}
+
+func (s *MediaService) List(params MediaListParams) ([]Media, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *MediaService) ListContext(ctx context.Context, params MediaListParams) ([]Media, *Response, error) {
+	u := s.endPoint()
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		MediaList []Media `json:"media_list"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.MediaList, resp, err
+}
+
+func (s *MediaService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *MediaService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.endPoint(sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
+// Download streams the media content. Twilio redirects to the storage serving the file, which is followed
+// transparently; the content type is available from the returned response header. The caller must close the returned body.
+func (s *MediaService) Download(sid string) (io.ReadCloser, *Response, error) {
+	return s.DownloadContext(context.Background(), sid)
+}
+
+// DownloadContext is like Download but bound to ctx.
+func (s *MediaService) DownloadContext(ctx context.Context, sid string) (io.ReadCloser, *Response, error) {
+	// content is served from the media uri without extension
+	u := s.endPoint(sid)
+	u.Path = strings.TrimSuffix(u.Path, "."+apiFormat)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := s.client.DoStream(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
@@ -0,0 +1,95 @@
+package twilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMediaService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "MM1234", "Media")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": 0, "media_list": [{"sid": "ME1234", "parent_sid": "MM1234", "content_type": "image/jpeg"}]}`)
+	})
+
+	ml, _, err := client.Messages.Media("MM1234").List(MediaListParams{})
+	if err != nil {
+		t.Errorf("Media.List returned error: %v", err)
+	}
+
+	want := []Media{{Sid: "ME1234", ParentSid: "MM1234", ContentType: "image/jpeg"}}
+	if !reflect.DeepEqual(ml, want) {
+		t.Errorf("Media.List returned %+v, want %+v", ml, want)
+	}
+}
+
+func TestMediaService_GetDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "MM1234", "Media", "ME1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		fmt.Fprint(w, `{"sid": "ME1234", "content_type": "image/png"}`)
+	})
+
+	s := client.Messages.Media("MM1234")
+
+	m, _, err := s.Get("ME1234")
+	if err != nil {
+		t.Errorf("Media.Get returned error: %v", err)
+	}
+
+	want := &Media{Sid: "ME1234", ContentType: "image/png"}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("Media.Get returned %+v, want %+v", m, want)
+	}
+
+	if _, err := s.Delete("ME1234"); err != nil {
+		t.Errorf("Media.Delete returned error: %v", err)
+	}
+}
+
+func TestMediaService_Download(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/2010-04-01/Accounts/AC5ef87/Messages/MM1234/Media/ME1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, "/storage/ME1234", http.StatusTemporaryRedirect)
+	})
+
+	mux.HandleFunc("/storage/ME1234", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "PNG")
+	})
+
+	in := InboundMedia{Url: server.URL + "/2010-04-01/Accounts/AC5ef87/Messages/MM1234/Media/ME1234"}
+
+	body, r, err := client.Messages.Media("MM1234").Download(in.Sid())
+	if err != nil {
+		t.Fatalf("Media.Download returned error: %v", err)
+	}
+	defer body.Close()
+
+	b, _ := ioutil.ReadAll(body)
+	if string(b) != "PNG" {
+		t.Errorf("Media.Download returned %q, want %q", b, "PNG")
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "image/png" {
+		t.Errorf("Media.Download content type = %q, want %q", ct, "image/png")
+	}
+}
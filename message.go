@@ -1,9 +1,13 @@
 package twilio
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/url"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type MessageService struct {
@@ -11,27 +15,40 @@ type MessageService struct {
 }
 
 type Message struct {
-	AccountSid  string    `json:"account_sid"`
-	ApiVersion  string    `json:"api_version"`
-	Body        string    `json:"body"`
-	NumSegments int       `json:"num_segments,string"`
-	NumMedia    int       `json:"num_media,string"`
-	DateCreated Timestamp `json:"date_created,omitempty"`
-	DateSent    Timestamp `json:"date_sent,omitempty"`
-	DateUpdated Timestamp `json:"date_updated,omitempty"`
-	Direction   string    `json:"direction"`
-	From        string    `json:"from"`
-	Price       Price     `json:"price,omitempty"`
-	Sid         string    `json:"sid"`
-	Status      string    `json:"status"`
-	To          string    `json:"to"`
-	Uri         string    `json:"uri"`
+	AccountSid  string        `json:"account_sid"`
+	ApiVersion  string        `json:"api_version"`
+	Body        string        `json:"body"`
+	NumSegments int           `json:"num_segments,string"`
+	NumMedia    int           `json:"num_media,string"`
+	DateCreated Timestamp     `json:"date_created,omitempty"`
+	DateSent    Timestamp     `json:"date_sent,omitempty"`
+	DateUpdated Timestamp     `json:"date_updated,omitempty"`
+	Direction   string        `json:"direction"`
+	From        string        `json:"from"`
+	Price       Price         `json:"price,omitempty"`
+	Sid         string        `json:"sid"`
+	Status      MessageStatus `json:"status"`
+	To          string        `json:"to"`
+	Uri         string        `json:"uri"`
 }
 
 func (m *Message) IsSent() bool {
-	return m.Status == "sent"
+	return m.Status == MessageStatusSent
 }
 
+// FromNumber returns the sender as PhoneNumber.
+func (m *Message) FromNumber() PhoneNumber {
+	return PhoneNumber(m.From)
+}
+
+// ToNumber returns the recipient as PhoneNumber.
+func (m *Message) ToNumber() PhoneNumber {
+	return PhoneNumber(m.To)
+}
+
+// MaxBodyLength is the maximum number of characters of a message body. See MessageService.SendSplit for longer ones.
+const MaxBodyLength = 1600
+
 type MessageParams struct {
 	// The text of the message you want to send, limited to 1600 characters.
 	Body string
@@ -41,23 +58,135 @@ type MessageParams struct {
 
 	StatusCallback string
 	ApplicationSid string
+
+	// Sender and recipient, used when the from and to arguments of Send are blank. They must be normalized,
+	// see ParsePhoneNumber.
+	From PhoneNumber
+	To   PhoneNumber
+
+	// The messaging service used to send the message. When set, "From" may be left blank and the service
+	// picks a sender from its pool.
+	MessagingServiceSid string
+
+	// Maximum price in US dollars to pay for the message. Messages exceeding it are failed instead of sent.
+	MaxPrice float64
+
+	// Whether to track delivery confirmation through the message Feedback resource.
+	ProvideFeedback bool
+
+	// Seconds the message may wait in the queue before being failed, between 1 and 36000.
+	ValidityPeriod int
+
+	// Time at which the message is sent, between 15 minutes and 35 days from now. Requires "MessagingServiceSid"
+	// and "ScheduleType".
+	SendAt       time.Time
+	ScheduleType string
+
+	// Whether to replace Unicode characters having a GSM-7 look-alike, to reduce the number of segments.
+	SmartEncoded *bool
+
+	// Whether to shorten links in the body. Requires "MessagingServiceSid".
+	ShortenUrls bool
+
+	// Actions attached to the message, eg: "mailto:user@example.com".
+	PersistentAction []string
+
+	// Content template to send instead of "Body", with its variables as a JSON object, eg: {"1": "Jane"}.
+	ContentSid       string
+	ContentVariables string
+
+	// Total number of attempts made to send the message, including this one.
+	Attempt int
+
+	// Whether to skip the checks made on the destination before sending.
+	ForceDelivery bool
 }
 
+// ScheduleTypeFixed is the only supported "ScheduleType", sending the message at "SendAt".
+const ScheduleTypeFixed = "fixed"
+
 func (p MessageParams) Validates() error {
-	if (p.Body == "") && (len(p.MediaUrl) == 0) {
-		return errors.New(`One of the "Body" or "MediaUrl" is required.`)
+	if (p.Body == "") && (len(p.MediaUrl) == 0) && (p.ContentSid == "") {
+		return errors.New(`One of the "Body", "MediaUrl" or "ContentSid" is required.`)
+	}
+
+	if utf8.RuneCountInString(p.Body) > MaxBodyLength {
+		return errors.New(`"Body" must not exceed 1600 characters.`)
+	}
+
+	if p.From != "" {
+		if err := p.From.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.To != "" {
+		if err := p.To.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.MaxPrice < 0 {
+		return errors.New(`"MaxPrice" must not be negative.`)
+	}
+
+	if p.ValidityPeriod < 0 || p.ValidityPeriod > 36000 {
+		return errors.New(`"ValidityPeriod" must be between 1 and 36000.`)
+	}
+
+	if p.Attempt < 0 {
+		return errors.New(`"Attempt" must not be negative.`)
+	}
+
+	if p.ShortenUrls && p.MessagingServiceSid == "" {
+		return errors.New(`"ShortenUrls" requires "MessagingServiceSid".`)
+	}
+
+	if p.ContentVariables != "" {
+		if p.ContentSid == "" {
+			return errors.New(`"ContentVariables" requires "ContentSid".`)
+		}
+
+		var vars map[string]interface{}
+		if json.Unmarshal([]byte(p.ContentVariables), &vars) != nil || vars == nil {
+			return errors.New(`"ContentVariables" must be a JSON object.`)
+		}
+	}
+
+	if p.ScheduleType != "" && p.ScheduleType != ScheduleTypeFixed {
+		return errors.New(`"ScheduleType" must be "fixed".`)
+	}
+
+	if p.SendAt.IsZero() != (p.ScheduleType == "") {
+		return errors.New(`"SendAt" and "ScheduleType" must be set together.`)
+	}
+
+	if !p.SendAt.IsZero() {
+		if p.MessagingServiceSid == "" {
+			return errors.New(`"SendAt" requires "MessagingServiceSid".`)
+		}
+
+		d := time.Until(p.SendAt)
+		if d < 15*time.Minute || d > 35*24*time.Hour {
+			return errors.New(`"SendAt" must be between 15 minutes and 35 days from now.`)
+		}
 	}
 
 	return nil
 }
 
 func (s *MessageService) Create(v url.Values) (*Message, *Response, error) {
+	return s.CreateContext(context.Background(), v)
+}
+
+// CreateContext is like Create but bound to ctx.
+func (s *MessageService) CreateContext(ctx context.Context, v url.Values) (*Message, *Response, error) {
 	u := s.client.EndPoint("Messages")
 
-	req, _ := s.client.NewRequest("POST", u.String(), strings.NewReader(v.Encode()))
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
 
 	m := new(Message)
-	resp, err := s.client.Do(req, m)
+	resp, err := s.client.DoContext(ctx, req, m)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -81,26 +210,43 @@ func (s *MessageService) SendSMS(from, to, body string) (*Message, *Response, er
 //
 //	StatusCallback : A URL that Twilio will POST to when your message is processed.
 //	ApplicationSid : Twilio will POST `MessageSid` as well as other statuses to the URL in the `MessageStatusCallback` property of this application
+//
+// See MessageParams for the others. Blank from and to are taken from MessageParams, and from may be left blank
+// when sending through a messaging service.
 func (s *MessageService) Send(from, to string, params MessageParams) (*Message, *Response, error) {
+	return s.SendContext(context.Background(), from, to, params)
+}
+
+// SendContext is like Send but bound to ctx.
+func (s *MessageService) SendContext(ctx context.Context, from, to string, params MessageParams) (*Message, *Response, error) {
 	err := params.Validates()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	v := structToUrlValues(&params)
-	v.Set("From", from)
-	v.Set("To", to)
+	if from != "" || (params.From == "" && params.MessagingServiceSid == "") {
+		v.Set("From", from)
+	}
+	if to != "" || params.To == "" {
+		v.Set("To", to)
+	}
 
-	return s.Create(v)
+	return s.CreateContext(ctx, v)
 }
 
 func (s *MessageService) Get(sid string) (*Message, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *MessageService) GetContext(ctx context.Context, sid string) (*Message, *Response, error) {
 	u := s.client.EndPoint("Messages", sid)
 
-	req, _ := s.client.NewRequest("GET", u.String(), nil)
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
 
 	m := new(Message)
-	resp, err := s.client.Do(req, m)
+	resp, err := s.client.DoContext(ctx, req, m)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -108,6 +254,50 @@ func (s *MessageService) Get(sid string) (*Message, *Response, error) {
 	return m, resp, err
 }
 
+// Update modifies a message, eg: redacting its body or canceling it while scheduled.
+func (s *MessageService) Update(sid string, v url.Values) (*Message, *Response, error) {
+	return s.UpdateContext(context.Background(), sid, v)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *MessageService) UpdateContext(ctx context.Context, sid string, v url.Values) (*Message, *Response, error) {
+	u := s.client.EndPoint("Messages", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	m := new(Message)
+	resp, err := s.client.DoContext(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// Redact blanks the body of a sent or received message, keeping the rest of its record.
+func (s *MessageService) Redact(sid string) (*Message, *Response, error) {
+	return s.Update(sid, url.Values{"Body": {""}})
+}
+
+// Cancel cancels a scheduled message before it's sent.
+func (s *MessageService) Cancel(sid string) (*Message, *Response, error) {
+	return s.Update(sid, url.Values{"Status": {string(MessageStatusCanceled)}})
+}
+
+// Delete removes the message record from the account.
+func (s *MessageService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *MessageService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint("Messages", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
 type MessageListParams struct {
 	To       string
 	From     string
@@ -116,30 +306,18 @@ type MessageListParams struct {
 }
 
 func (s *MessageService) List(params MessageListParams) ([]Message, *Response, error) {
-	u := s.client.EndPoint("Messages")
-	v := structToUrlValues(&params)
-
-	req, _ := s.client.NewRequest("GET", u.String(), nil)
+	return s.ListContext(context.Background(), params)
+}
 
-	// params are url query params
-	q := req.URL.Query()
-	for k, vals := range v {
-		for _, v := range vals {
-			if v != "" { // only set for non blank values
-				q.Add(k, v)
-			}
-		}
-	}
-	req.URL.RawQuery = q.Encode()
+// ListContext is like List but bound to ctx.
+func (s *MessageService) ListContext(ctx context.Context, params MessageListParams) ([]Message, *Response, error) {
+	u := s.client.EndPoint("Messages")
+	setQuery(u, structToUrlValues(&params))
 
-	// Helper struct for handling the listing
-	type list struct {
-		Pagination
-		Messages []Message `json:"messages"`
-	}
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
 
-	l := new(list)
-	resp, err := s.client.Do(req, l)
+	l := new(messageList)
+	resp, err := s.client.DoContext(ctx, req, l)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -148,3 +326,62 @@ func (s *MessageService) List(params MessageListParams) ([]Message, *Response, e
 
 	return l.Messages, resp, err
 }
+
+// Helper struct for handling the listing
+type messageList struct {
+	Pagination
+	Messages []Message `json:"messages"`
+}
+
+// ListIter returns an iterator over all messages matching params, following next page as needed.
+//
+//	it := c.Messages.ListIter(twilio.MessageListParams{To: "+15558675309"})
+//	it.Limit = 100
+//	for it.Next() {
+//		m := it.Message()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+func (s *MessageService) ListIter(params MessageListParams) *MessageIter {
+	return s.ListIterContext(context.Background(), params)
+}
+
+// ListIterContext is like ListIter but bound to ctx.
+func (s *MessageService) ListIterContext(ctx context.Context, params MessageListParams) *MessageIter {
+	u := s.client.EndPoint("Messages")
+	setQuery(u, structToUrlValues(&params))
+
+	return &MessageIter{Pager: newPager(ctx, s.client, u.String())}
+}
+
+// MessageIter iterates over messages across pages. See MessageService.ListIter.
+type MessageIter struct {
+	*Pager
+	page []Message
+	cur  Message
+}
+
+// Next advances to the next message, fetching the next page when needed. It returns false when
+// the iteration is over, either because all messages were returned, the limit was reached or an error occurred.
+func (it *MessageIter) Next() bool {
+	for len(it.page) == 0 {
+		l := new(messageList)
+		if !it.nextPage(l) {
+			return false
+		}
+		it.page = l.Messages
+	}
+
+	if !it.take() {
+		return false
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Message returns the current message.
+func (it *MessageIter) Message() Message {
+	return it.cur
+}
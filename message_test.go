@@ -1,11 +1,13 @@
 package twilio
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestMessage_IsSent(t *testing.T) {
@@ -29,6 +31,89 @@ func TestMessageParams_Validates(t *testing.T) {
 	}
 }
 
+func TestMessageParams_Validates_constraints(t *testing.T) {
+	sendAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		params MessageParams
+		valid  bool
+	}{
+		{MessageParams{ContentSid: "HX1234", ContentVariables: `{"1": "Jane"}`}, true},
+		{MessageParams{Body: "Hi", ContentVariables: `{"1": "Jane"}`}, false},
+		{MessageParams{ContentSid: "HX1234", ContentVariables: `{"1":`}, false},
+		{MessageParams{ContentSid: "HX1234", ContentVariables: `[1]`}, false},
+		{MessageParams{ContentSid: "HX1234", ContentVariables: `"x"`}, false},
+		{MessageParams{ContentSid: "HX1234", ContentVariables: `null`}, false},
+		{MessageParams{Body: "Hi", ValidityPeriod: 36001}, false},
+		{MessageParams{Body: "Hi", MaxPrice: -1}, false},
+		{MessageParams{Body: "Hi", ShortenUrls: true}, false},
+		{MessageParams{Body: "Hi", ShortenUrls: true, MessagingServiceSid: "MG1234"}, true},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", SendAt: sendAt, ScheduleType: ScheduleTypeFixed}, true},
+		{MessageParams{Body: "Hi", SendAt: sendAt, ScheduleType: ScheduleTypeFixed}, false},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", SendAt: sendAt}, false},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", ScheduleType: ScheduleTypeFixed}, false},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", SendAt: sendAt, ScheduleType: "daily"}, false},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", SendAt: time.Now().Add(time.Minute), ScheduleType: ScheduleTypeFixed}, false},
+		{MessageParams{Body: "Hi", MessagingServiceSid: "MG1234", SendAt: time.Now().Add(36 * 24 * time.Hour), ScheduleType: ScheduleTypeFixed}, false},
+	}
+
+	for i, tt := range tests {
+		if err := tt.params.Validates(); (err == nil) != tt.valid {
+			t.Errorf("%d. MessageParams.Validates returned %v, want valid %v", i, err, tt.valid)
+		}
+	}
+}
+
+func TestMessageService_Send_messagingService(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+	sendAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		if _, ok := r.PostForm["From"]; ok {
+			t.Errorf("Request From = %q, want none", r.PostForm.Get("From"))
+		}
+
+		want := map[string]string{
+			"MessagingServiceSid": "MG1234",
+			"SendAt":              sendAt.UTC().Format(time.RFC3339),
+			"ScheduleType":        "fixed",
+			"SmartEncoded":        "false",
+			"ValidityPeriod":      "600",
+		}
+		for k, v := range want {
+			if got := r.PostForm.Get(k); got != v {
+				t.Errorf("Request %s = %q, want %q", k, got, v)
+			}
+		}
+
+		fmt.Fprint(w, `{"sid": "SM1234", "status": "scheduled"}`)
+	})
+
+	params := MessageParams{
+		Body:                "Hello",
+		MessagingServiceSid: "MG1234",
+		SendAt:              sendAt,
+		ScheduleType:        ScheduleTypeFixed,
+		SmartEncoded:        Bool(false),
+		ValidityPeriod:      600,
+	}
+
+	m, _, err := client.Messages.Send("", "+15558675309", params)
+	if err != nil {
+		t.Errorf("Message.Send returned error: %v", err)
+	}
+
+	if m.Status != MessageStatusScheduled {
+		t.Errorf("Message.Send returned status %q, want %q", m.Status, MessageStatusScheduled)
+	}
+}
+
 func TestMessageService_Create(t *testing.T) {
 	setup()
 	defer teardown()
@@ -333,3 +418,117 @@ func TestMessageService_List_httpError(t *testing.T) {
 		t.Error("Expected HTTP 400 errror.")
 	}
 }
+
+func TestMessageService_SendContext_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := client.Messages.SendContext(ctx, "+14158141829", "+15558675309", MessageParams{Body: "Hello"})
+
+	if err != context.Canceled {
+		t.Errorf("Message.SendContext returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestMessageService_GetContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "abc")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sid": "abc"}`)
+	})
+
+	m, _, err := client.Messages.GetContext(context.Background(), "abc")
+
+	if err != nil {
+		t.Errorf("Message.GetContext returned error: %v", err)
+	}
+
+	want := &Message{Sid: "abc"}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("Message.GetContext returned %+v, want %+v", m, want)
+	}
+}
+
+func TestMessageService_Redact(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "MM1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		if b, ok := r.PostForm["Body"]; !ok || b[0] != "" {
+			t.Errorf("Request Body = %v, want blank", b)
+		}
+
+		fmt.Fprint(w, `{"sid": "MM1234", "body": "", "status": "delivered"}`)
+	})
+
+	m, _, err := client.Messages.Redact("MM1234")
+	if err != nil {
+		t.Errorf("Message.Redact returned error: %v", err)
+	}
+
+	want := &Message{Sid: "MM1234", Status: MessageStatusDelivered}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("Message.Redact returned %+v, want %+v", m, want)
+	}
+}
+
+func TestMessageService_Cancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "MM1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		fmt.Fprintf(w, `{"sid": "MM1234", "status": %q}`, r.PostForm.Get("Status"))
+	})
+
+	m, _, err := client.Messages.Cancel("MM1234")
+	if err != nil {
+		t.Errorf("Message.Cancel returned error: %v", err)
+	}
+
+	if m.Status != MessageStatusCanceled {
+		t.Errorf("Message.Cancel returned status %q, want %q", m.Status, MessageStatusCanceled)
+	}
+}
+
+func TestMessageService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages", "MM1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r, err := client.Messages.Delete("MM1234")
+	if err != nil {
+		t.Errorf("Message.Delete returned error: %v", err)
+	}
+
+	if r.StatusCode != http.StatusNoContent {
+		t.Errorf("Message.Delete status code = %d, want %d", r.StatusCode, http.StatusNoContent)
+	}
+}
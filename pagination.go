@@ -1,5 +1,9 @@
 package twilio
 
+import (
+	"context"
+)
+
 type Pagination struct {
 	Page            int    `json:"page"`
 	NumPages        int    `json:"num_pages"`
@@ -13,3 +17,80 @@ type Pagination struct {
 	NextPageUri     string `json:"next_page_uri"`
 	LastPageUri     string `json:"last_page_uri"`
 }
+
+func (p *Pagination) pagination() *Pagination {
+	return p
+}
+
+// pageable is implemented by list responses embedding Pagination.
+type pageable interface {
+	pagination() *Pagination
+}
+
+// Pager lazily follows next_page_uri across the pages of a list endpoint. It's embedded by typed iterators
+// such as MessageIter, which fetch pages on demand while the caller keeps asking for more items.
+type Pager struct {
+	// Maximum number of items returned across all pages. Zero means no limit.
+	Limit int
+
+	client *Client
+	ctx    context.Context
+	next   string
+	count  int
+	resp   *Response
+	err    error
+}
+
+func newPager(ctx context.Context, c *Client, uri string) *Pager {
+	return &Pager{client: c, ctx: ctx, next: uri}
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last fetched page.
+func (p *Pager) Response() *Response {
+	return p.resp
+}
+
+// nextPage fetches the next page into v. It returns false when there are no more pages,
+// the limit has been reached or the request failed.
+func (p *Pager) nextPage(v pageable) bool {
+	if p.err != nil || p.next == "" || p.done() {
+		return false
+	}
+
+	req, err := p.client.NewRequestWithContext(p.ctx, "GET", p.next, nil)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	resp, err := p.client.DoContext(p.ctx, req, v)
+	p.resp = resp
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	resp.Pagination = *v.pagination()
+	p.next = resp.NextPageUri
+
+	return true
+}
+
+// take accounts for one more item returned to the caller. It returns false once the limit is reached.
+func (p *Pager) take() bool {
+	if p.done() {
+		return false
+	}
+
+	p.count++
+	return true
+}
+
+func (p *Pager) done() bool {
+	return p.Limit > 0 && p.count >= p.Limit
+}
@@ -2,6 +2,8 @@ package twilio
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -45,3 +47,98 @@ func TestPagination(t *testing.T) {
 		t.Errorf("Pagination returned %+v, want %+v", p, want)
 	}
 }
+
+func TestMessageService_ListIter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("Page") {
+		case "":
+			if to := r.URL.Query().Get("To"); to != "+15558675309" {
+				t.Errorf("Request To = %q, want %q", to, "+15558675309")
+			}
+			fmt.Fprintf(w, `{"page": 0, "next_page_uri": "%s?Page=1", "messages": [{"sid": "MM1"}, {"sid": "MM2"}]}`, u.Path)
+		case "1":
+			fmt.Fprintf(w, `{"page": 1, "next_page_uri": "%s?Page=2", "messages": []}`, u.Path)
+		case "2":
+			fmt.Fprint(w, `{"page": 2, "next_page_uri": null, "messages": [{"sid": "MM3"}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.URL.Query().Get("Page"))
+		}
+	})
+
+	it := client.Messages.ListIter(MessageListParams{To: "+15558675309"})
+
+	var sids []string
+	for it.Next() {
+		sids = append(sids, it.Message().Sid)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Errorf("MessageIter.Err() returned %v", err)
+	}
+
+	want := []string{"MM1", "MM2", "MM3"}
+	if !reflect.DeepEqual(sids, want) {
+		t.Errorf("MessageIter returned %v, want %v", sids, want)
+	}
+
+	if p := it.Response().Page; p != 2 {
+		t.Errorf("MessageIter.Response().Page = %d, want %d", p, 2)
+	}
+}
+
+func TestMessageService_ListIter_limit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	requests := 0
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"next_page_uri": "%s?Page=%d", "messages": [{"sid": "MM1"}, {"sid": "MM2"}]}`, u.Path, requests)
+	})
+
+	it := client.Messages.ListIter(MessageListParams{})
+	it.Limit = 3
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if n != 3 {
+		t.Errorf("MessageIter returned %d messages, want %d", n, 3)
+	}
+
+	if requests != 2 {
+		t.Errorf("MessageIter made %d requests, want %d", requests, 2)
+	}
+}
+
+func TestMessageService_ListIter_httpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	it := client.Messages.ListIter(MessageListParams{})
+
+	if it.Next() {
+		t.Error("MessageIter.Next() should be false")
+	}
+
+	if it.Err() == nil {
+		t.Error("Expected HTTP 400 errror.")
+	}
+}
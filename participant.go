@@ -0,0 +1,204 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ParticipantService manages participants of a conference. See ConferenceService.Participants.
+type ParticipantService struct {
+	client        *Client
+	conferenceSid string
+}
+
+type Participant struct {
+	CallSid                string    `json:"call_sid"`
+	ConferenceSid          string    `json:"conference_sid"`
+	AccountSid             string    `json:"account_sid"`
+	Label                  string    `json:"label"`
+	Status                 string    `json:"status"`
+	Muted                  bool      `json:"muted"`
+	Hold                   bool      `json:"hold"`
+	Coaching               bool      `json:"coaching"`
+	CallSidToCoach         string    `json:"call_sid_to_coach"`
+	StartConferenceOnEnter bool      `json:"start_conference_on_enter"`
+	EndConferenceOnExit    bool      `json:"end_conference_on_exit"`
+	DateCreated            Timestamp `json:"date_created,omitempty"`
+	DateUpdated            Timestamp `json:"date_updated,omitempty"`
+	Uri                    string    `json:"uri"`
+}
+
+// Participants returns the service managing participants of the conference sid.
+func (s *ConferenceService) Participants(sid string) *ParticipantService {
+	return &ParticipantService{client: s.client, conferenceSid: sid}
+}
+
+func (s *ParticipantService) endPoint(parts ...string) *url.URL {
+	return s.client.EndPoint(append([]string{"Conferences", s.conferenceSid, "Participants"}, parts...)...)
+}
+
+type ParticipantParams struct {
+	// A label identifying the participant, usable instead of its call sid.
+	Label string
+
+	// A URL that Twilio will request on the events listed in StatusCallbackEvent: initiated, ringing, answered and completed.
+	StatusCallback       string
+	StatusCallbackMethod string
+	StatusCallbackEvent  []string
+
+	// Seconds to let the call ring before assuming there is no answer.
+	Timeout int
+
+	Muted                  bool
+	StartConferenceOnEnter *bool
+	EndConferenceOnExit    bool
+
+	// Either "true", "false", "onEnter" or "onExit".
+	Beep string
+
+	// TwiML or audio file played while waiting for the conference to start.
+	WaitUrl    string
+	WaitMethod string
+
+	EarlyMedia bool
+	Record     bool
+	CallerId   string
+
+	// A URL that Twilio will request on conference events listed in ConferenceStatusCallbackEvent.
+	ConferenceStatusCallback      string
+	ConferenceStatusCallbackEvent []string
+}
+
+// Add dials to and connects the call to the conference once answered.
+func (s *ParticipantService) Add(from, to string, params ParticipantParams) (*Participant, *Response, error) {
+	return s.AddContext(context.Background(), from, to, params)
+}
+
+// AddContext is like Add but bound to ctx.
+func (s *ParticipantService) AddContext(ctx context.Context, from, to string, params ParticipantParams) (*Participant, *Response, error) {
+	v := structToUrlValues(&params)
+	v.Set("From", from)
+	v.Set("To", to)
+
+	u := s.endPoint()
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	p := new(Participant)
+	resp, err := s.client.DoContext(ctx, req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// Get fetches a participant by its call sid or label.
+func (s *ParticipantService) Get(callSid string) (*Participant, *Response, error) {
+	return s.GetContext(context.Background(), callSid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *ParticipantService) GetContext(ctx context.Context, callSid string) (*Participant, *Response, error) {
+	u := s.endPoint(callSid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	p := new(Participant)
+	resp, err := s.client.DoContext(ctx, req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+type ParticipantListParams struct {
+	Muted    *bool
+	Hold     *bool
+	Coaching *bool
+	PageSize int
+}
+
+func (s *ParticipantService) List(params ParticipantListParams) ([]Participant, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *ParticipantService) ListContext(ctx context.Context, params ParticipantListParams) ([]Participant, *Response, error) {
+	u := s.endPoint()
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		Participants []Participant `json:"participants"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Participants, resp, err
+}
+
+// Update modifies a participant, eg: muting, holding or announcing to it.
+func (s *ParticipantService) Update(callSid string, v url.Values) (*Participant, *Response, error) {
+	return s.UpdateContext(context.Background(), callSid, v)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *ParticipantService) UpdateContext(ctx context.Context, callSid string, v url.Values) (*Participant, *Response, error) {
+	u := s.endPoint(callSid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	p := new(Participant)
+	resp, err := s.client.DoContext(ctx, req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// Mute mutes or unmutes the participant.
+func (s *ParticipantService) Mute(callSid string, muted bool) (*Participant, *Response, error) {
+	return s.Update(callSid, url.Values{"Muted": {strconv.FormatBool(muted)}})
+}
+
+// Hold puts the participant on hold or takes it back. Hold music is played from holdUrl when it's not blank.
+func (s *ParticipantService) Hold(callSid string, hold bool, holdUrl string) (*Participant, *Response, error) {
+	v := url.Values{"Hold": {strconv.FormatBool(hold)}}
+	if holdUrl != "" {
+		v.Set("HoldUrl", holdUrl)
+	}
+
+	return s.Update(callSid, v)
+}
+
+// Announce plays the TwiML or audio file at announceUrl to the participant.
+func (s *ParticipantService) Announce(callSid, announceUrl string) (*Participant, *Response, error) {
+	return s.Update(callSid, url.Values{"AnnounceUrl": {announceUrl}})
+}
+
+// Kick removes the participant from the conference, hanging up its call.
+func (s *ParticipantService) Kick(callSid string) (*Response, error) {
+	return s.KickContext(context.Background(), callSid)
+}
+
+// KickContext is like Kick but bound to ctx.
+func (s *ParticipantService) KickContext(ctx context.Context, callSid string) (*Response, error) {
+	u := s.endPoint(callSid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
@@ -0,0 +1,124 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParticipantService_Add(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences", "CF1234", "Participants")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		want := map[string][]string{
+			"From":                   {"+14158141829"},
+			"To":                     {"+15558675309"},
+			"Label":                  {"customer"},
+			"StartConferenceOnEnter": {"false"},
+			"Beep":                   {"onEnter"},
+		}
+
+		if !reflect.DeepEqual(map[string][]string(r.PostForm), want) {
+			t.Errorf("Request form = %v, want %v", r.PostForm, want)
+		}
+
+		fmt.Fprint(w, `{"call_sid": "CA1234", "conference_sid": "CF1234", "label": "customer", "status": "queued"}`)
+	})
+
+	params := ParticipantParams{
+		Label:                  "customer",
+		StartConferenceOnEnter: Bool(false),
+		Beep:                   "onEnter",
+	}
+
+	p, _, err := client.Conferences.Participants("CF1234").Add("+14158141829", "+15558675309", params)
+	if err != nil {
+		t.Errorf("Participant.Add returned error: %v", err)
+	}
+
+	want := &Participant{CallSid: "CA1234", ConferenceSid: "CF1234", Label: "customer", Status: "queued"}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("Participant.Add returned %+v, want %+v", p, want)
+	}
+}
+
+func TestParticipantService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences", "CF1234", "Participants")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if m := r.URL.Query().Get("Muted"); m != "true" {
+			t.Errorf("Request Muted = %q, want %q", m, "true")
+		}
+
+		fmt.Fprint(w, `{"page": 0, "participants": [{"call_sid": "CA1234", "muted": true}]}`)
+	})
+
+	pl, _, err := client.Conferences.Participants("CF1234").List(ParticipantListParams{Muted: Bool(true)})
+	if err != nil {
+		t.Errorf("Participant.List returned error: %v", err)
+	}
+
+	want := []Participant{{CallSid: "CA1234", Muted: true}}
+	if !reflect.DeepEqual(pl, want) {
+		t.Errorf("Participant.List returned %+v, want %+v", pl, want)
+	}
+}
+
+func TestParticipantService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Conferences", "CF1234", "Participants", "CA1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"call_sid": "CA1234"}`)
+		case "POST":
+			r.ParseForm()
+			fmt.Fprintf(w, `{"call_sid": "CA1234", "muted": %s, "hold": %s}`, orFalse(r.PostForm.Get("Muted")), orFalse(r.PostForm.Get("Hold")))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	s := client.Conferences.Participants("CF1234")
+
+	if _, _, err := s.Get("CA1234"); err != nil {
+		t.Errorf("Participant.Get returned error: %v", err)
+	}
+
+	p, _, err := s.Mute("CA1234", true)
+	if err != nil || !p.Muted {
+		t.Errorf("Participant.Mute returned %+v, %v", p, err)
+	}
+
+	p, _, err = s.Hold("CA1234", true, "http://example.com/hold.mp3")
+	if err != nil || !p.Hold {
+		t.Errorf("Participant.Hold returned %+v, %v", p, err)
+	}
+
+	if _, err := s.Kick("CA1234"); err != nil {
+		t.Errorf("Participant.Kick returned error: %v", err)
+	}
+}
+
+func orFalse(s string) string {
+	if s == "" {
+		return "false"
+	}
+
+	return s
+}
@@ -0,0 +1,278 @@
+package twilio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPhoneNumber is returned when a phone number can't be parsed or validated.
+var ErrInvalidPhoneNumber = errors.New("twilio: invalid phone number")
+
+// PhoneNumber is a message address normalized by ParsePhoneNumber. It's either a phone number in E.164 format,
+// eg: "+14155552671", a short code, eg: "12345", or an address on another channel, prefixed by its name:
+//
+//	whatsapp:+14155552671
+//	messenger:1234567890
+//
+// It's accepted by MessageParams, and addresses of messages and webhooks are available as PhoneNumber through
+// their FromNumber and ToNumber methods.
+type PhoneNumber string
+
+const (
+	ChannelWhatsApp  = "whatsapp"
+	ChannelMessenger = "messenger"
+)
+
+type region struct {
+	callingCode string
+
+	// Prefix dialed before national numbers, dropped in E.164.
+	trunkPrefix string
+}
+
+// regions lists the regions whose national format is understood by ParsePhoneNumber.
+var regions = map[string]region{
+	"AE": {"971", "0"}, "AR": {"54", "0"}, "AT": {"43", "0"}, "AU": {"61", "0"}, "BD": {"880", "0"},
+	"BE": {"32", "0"}, "BR": {"55", "0"}, "CA": {"1", "1"}, "CH": {"41", "0"}, "CL": {"56", ""},
+	"CN": {"86", "0"}, "CO": {"57", ""}, "DE": {"49", "0"}, "DK": {"45", ""}, "EG": {"20", "0"},
+	"ES": {"34", ""}, "FI": {"358", "0"}, "FR": {"33", "0"}, "GB": {"44", "0"}, "HK": {"852", ""},
+	"ID": {"62", "0"}, "IE": {"353", "0"}, "IL": {"972", "0"}, "IN": {"91", "0"}, "IT": {"39", ""},
+	"JP": {"81", "0"}, "KE": {"254", "0"}, "KR": {"82", "0"}, "MX": {"52", ""}, "MY": {"60", "0"},
+	"NG": {"234", "0"}, "NL": {"31", "0"}, "NO": {"47", ""}, "NZ": {"64", "0"}, "PE": {"51", "0"},
+	"PH": {"63", "0"}, "PK": {"92", "0"}, "PL": {"48", ""}, "PR": {"1", "1"}, "PT": {"351", ""},
+	"RU": {"7", "8"}, "SA": {"966", "0"}, "SE": {"46", "0"}, "SG": {"65", ""}, "TH": {"66", "0"},
+	"TR": {"90", "0"}, "TW": {"886", "0"}, "US": {"1", "1"}, "VN": {"84", "0"}, "ZA": {"27", "0"},
+}
+
+// shortCodeLengths holds the lengths of short codes of some regions. Short codes of other regions have 3 to 6
+// digits, while 3 to 8 digits are accepted when the region isn't known.
+var shortCodeLengths = map[string][2]int{
+	"AU": {6, 8}, "CA": {5, 6}, "DE": {4, 5}, "FR": {5, 5}, "GB": {5, 5}, "IE": {5, 5}, "IN": {5, 6},
+	"PR": {5, 6}, "US": {5, 6},
+}
+
+// callingCodes lists the assigned country calling codes. No code is the prefix of another one.
+var callingCodes = map[string]bool{}
+
+func init() {
+	codes := "1 7 20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 51 52 53 54 55 56 57 58 " +
+		"60 61 62 63 64 65 66 81 82 84 86 90 91 92 93 94 95 98 211 212 213 216 218 " +
+		"220 221 222 223 224 225 226 227 228 229 230 231 232 233 234 235 236 237 238 239 " +
+		"240 241 242 243 244 245 246 247 248 249 250 251 252 253 254 255 256 257 258 " +
+		"260 261 262 263 264 265 266 267 268 269 290 291 297 298 299 " +
+		"350 351 352 353 354 355 356 357 358 359 370 371 372 373 374 375 376 377 378 379 " +
+		"380 381 382 383 385 386 387 389 420 421 423 500 501 502 503 504 505 506 507 508 509 " +
+		"590 591 592 593 594 595 596 597 598 599 670 672 673 674 675 676 677 678 679 " +
+		"680 681 682 683 685 686 687 688 689 690 691 692 850 852 853 855 856 880 886 " +
+		"960 961 962 963 964 965 966 967 968 970 971 972 973 974 975 976 977 992 993 994 995 996 998"
+
+	for _, c := range strings.Fields(codes) {
+		callingCodes[c] = true
+	}
+}
+
+// numberLengths holds the lengths of national numbers, without trunk prefix, of some calling codes.
+// Numbers of other codes may have up to 15 digits in total, as allowed by E.164.
+var numberLengths = map[string][2]int{
+	"1": {10, 10}, "7": {10, 10}, "20": {9, 10}, "27": {9, 9}, "31": {9, 9}, "32": {8, 9}, "33": {9, 9},
+	"34": {9, 9}, "39": {6, 11}, "41": {9, 9}, "43": {4, 13}, "44": {7, 10}, "45": {8, 8}, "46": {7, 10},
+	"47": {8, 8}, "48": {9, 9}, "49": {6, 13}, "51": {8, 9}, "52": {10, 10}, "54": {10, 11}, "55": {10, 11},
+	"56": {9, 9}, "57": {8, 10}, "60": {8, 10}, "61": {9, 9}, "62": {8, 12}, "63": {8, 10}, "64": {8, 10},
+	"65": {8, 8}, "66": {8, 9}, "81": {9, 10}, "82": {8, 10}, "84": {9, 10}, "86": {10, 11}, "90": {10, 10},
+	"91": {10, 10}, "92": {9, 10}, "234": {8, 10}, "254": {9, 9}, "351": {9, 9}, "353": {7, 9},
+	"358": {5, 12}, "852": {8, 8}, "880": {8, 10}, "886": {8, 9}, "966": {9, 9}, "971": {8, 9}, "972": {8, 9},
+}
+
+// ParsePhoneNumber normalizes s, written in international or national format, to a PhoneNumber. National numbers
+// are read as numbers of the default region, given as ISO 3166 code, eg: "US". Short codes of the region,
+// and addresses prefixed by "whatsapp:" or "messenger:" are recognized as well.
+//
+//	twilio.ParsePhoneNumber("(415) 555-2671", "US")           // +14155552671
+//	twilio.ParsePhoneNumber("020 7946 0958", "GB")            // +442079460958
+//	twilio.ParsePhoneNumber("whatsapp:+1 415 555 2671", "")   // whatsapp:+14155552671
+func ParsePhoneNumber(s, defaultRegion string) (PhoneNumber, error) {
+	s = strings.TrimSpace(s)
+
+	if channel, addr, ok := strings.Cut(s, ":"); ok {
+		switch strings.ToLower(channel) {
+		case ChannelWhatsApp:
+			if strings.Contains(addr, ":") {
+				return "", fmt.Errorf("%w %q: nested channel", ErrInvalidPhoneNumber, s)
+			}
+			p, err := ParsePhoneNumber(addr, defaultRegion)
+			if err != nil {
+				return "", err
+			}
+			if p.IsShortCode() {
+				return "", fmt.Errorf("%w %q: WhatsApp requires a full phone number", ErrInvalidPhoneNumber, s)
+			}
+			return PhoneNumber(ChannelWhatsApp + ":" + string(p)), nil
+		case ChannelMessenger:
+			addr = strings.TrimSpace(addr)
+			if addr == "" || !isDigits(addr) {
+				return "", fmt.Errorf("%w %q: invalid Messenger id", ErrInvalidPhoneNumber, s)
+			}
+			return PhoneNumber(ChannelMessenger + ":" + addr), nil
+		}
+
+		return "", fmt.Errorf("%w %q: unknown channel", ErrInvalidPhoneNumber, s)
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(" -.()/\t", r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	rgn, hasRegion := regions[strings.ToUpper(defaultRegion)]
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case hasRegion && rgn.callingCode == "1" && strings.HasPrefix(digits, "011"):
+		digits = digits[3:]
+	default:
+		return parseNational(s, digits, strings.ToUpper(defaultRegion))
+	}
+
+	if !isDigits(digits) {
+		return "", fmt.Errorf("%w %q", ErrInvalidPhoneNumber, s)
+	}
+
+	code := callingCode(digits)
+	if code == "" {
+		return "", fmt.Errorf("%w %q: unknown country calling code", ErrInvalidPhoneNumber, s)
+	}
+
+	if !validNational(code, digits[len(code):]) {
+		return "", fmt.Errorf("%w %q: invalid length for country calling code %s", ErrInvalidPhoneNumber, s, code)
+	}
+
+	return PhoneNumber("+" + digits), nil
+}
+
+func parseNational(s, digits, regionCode string) (PhoneNumber, error) {
+	if !isDigits(digits) {
+		return "", fmt.Errorf("%w %q", ErrInvalidPhoneNumber, s)
+	}
+
+	rgn, ok := regions[regionCode]
+	if !ok {
+		if 3 <= len(digits) && len(digits) <= 8 {
+			return PhoneNumber(digits), nil
+		}
+
+		return "", fmt.Errorf("%w %q: national number without a known default region", ErrInvalidPhoneNumber, s)
+	}
+
+	if rgn.trunkPrefix != "" && strings.HasPrefix(digits, rgn.trunkPrefix) {
+		if n := digits[len(rgn.trunkPrefix):]; validNational(rgn.callingCode, n) {
+			return PhoneNumber("+" + rgn.callingCode + n), nil
+		}
+	}
+
+	if validNational(rgn.callingCode, digits) {
+		return PhoneNumber("+" + rgn.callingCode + digits), nil
+	}
+
+	l, ok := shortCodeLengths[regionCode]
+	if !ok {
+		l = [2]int{3, 6}
+	}
+
+	if l[0] <= len(digits) && len(digits) <= l[1] {
+		return PhoneNumber(digits), nil
+	}
+
+	return "", fmt.Errorf("%w %q: invalid length for country calling code %s", ErrInvalidPhoneNumber, s, rgn.callingCode)
+}
+
+// callingCode returns the country calling code which digits start with, or "" when there's none.
+func callingCode(digits string) string {
+	for n := 1; n <= 3 && n <= len(digits); n++ {
+		if callingCodes[digits[:n]] {
+			return digits[:n]
+		}
+	}
+
+	return ""
+}
+
+// validNational reports whether national is a valid number for the calling code. Only lengths are checked,
+// along with the first digit of NANP area codes which can't be 0 or 1.
+func validNational(code, national string) bool {
+	if code == "1" && national != "" && national[0] < '2' {
+		return false
+	}
+
+	if l, ok := numberLengths[code]; ok {
+		return l[0] <= len(national) && len(national) <= l[1]
+	}
+
+	return 4 <= len(national) && len(code)+len(national) <= 15
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Channel returns the channel of the address, eg: "whatsapp", or "" for SMS.
+func (p PhoneNumber) Channel() string {
+	if channel, _, ok := strings.Cut(string(p), ":"); ok {
+		return channel
+	}
+
+	return ""
+}
+
+// Number returns the address without its channel prefix.
+func (p PhoneNumber) Number() string {
+	if _, addr, ok := strings.Cut(string(p), ":"); ok {
+		return addr
+	}
+
+	return string(p)
+}
+
+// IsShortCode reports whether p is a short code rather than a full phone number.
+func (p PhoneNumber) IsShortCode() bool {
+	return p.Channel() != ChannelMessenger && isDigits(p.Number())
+}
+
+// CountryCode returns the country calling code of the phone number, eg: "1", or "" for short codes
+// and Messenger ids.
+func (p PhoneNumber) CountryCode() string {
+	if n := p.Number(); strings.HasPrefix(n, "+") {
+		return callingCode(n[1:])
+	}
+
+	return ""
+}
+
+// Validate checks offline that p is a normalized address, as returned by ParsePhoneNumber.
+func (p PhoneNumber) Validate() error {
+	q, err := ParsePhoneNumber(string(p), "")
+	if err != nil {
+		return err
+	}
+
+	if q != p {
+		return fmt.Errorf("%w %q: not normalized, want %q", ErrInvalidPhoneNumber, string(p), string(q))
+	}
+
+	return nil
+}
+
+func (p PhoneNumber) String() string {
+	return string(p)
+}
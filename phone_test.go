@@ -0,0 +1,178 @@
+package twilio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestParsePhoneNumber(t *testing.T) {
+	tests := []struct {
+		in, region string
+		want       PhoneNumber
+	}{
+		{"+14155552671", "", "+14155552671"},
+		{"+1 (415) 555-2671", "", "+14155552671"},
+		{"(415) 555-2671", "US", "+14155552671"},
+		{"1-415-555-2671", "us", "+14155552671"},
+		{"011 44 20 7946 0958", "US", "+442079460958"},
+		{"020 7946 0958", "GB", "+442079460958"},
+		{"0044 20 7946 0958", "FR", "+442079460958"},
+		{"0812-3456-7890", "ID", "+6281234567890"},
+		{"06 1234 5678", "IT", "+390612345678"},
+		{"8 (912) 345-67-89", "RU", "+79123456789"},
+		{"+380 44 123 4567", "", "+380441234567"},
+		{"12345", "US", "12345"},
+		{"898211", "", "898211"},
+		{"898211", "US", "898211"},
+		{"1234567", "", "1234567"},
+		{"whatsapp:+1 415 555 2671", "", "whatsapp:+14155552671"},
+		{"WhatsApp:(415) 555-2671", "US", "whatsapp:+14155552671"},
+		{"messenger:1234567890", "", "messenger:1234567890"},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePhoneNumber(tt.in, tt.region)
+		if err != nil {
+			t.Errorf("ParsePhoneNumber(%q, %q) returned error: %v", tt.in, tt.region, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("ParsePhoneNumber(%q, %q) = %q, want %q", tt.in, tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestParsePhoneNumber_invalid(t *testing.T) {
+	tests := []struct {
+		in, region string
+	}{
+		{"", ""},
+		{"+1415555267", ""},
+		{"+999 1234 5678", ""},
+		{"+44 20 7946 0958 1", ""},
+		{"4155552671", ""},
+		{"415 555 267", "US"},
+		{"5552671", "US"},
+		{"1234", "US"},
+		{"1234567", "MX"},
+		{"415-CALL-NOW", "US"},
+		{"whatsapp:12345", "US"},
+		{"messenger:jane", ""},
+		{"fax:+14155552671", ""},
+		{"whatsapp:whatsapp:+14155552671", "US"},
+		{"whatsapp:messenger:123", ""},
+		{"1415555267", "US"},
+		{"+11415555267", ""},
+		{"+10415555267", ""},
+		{"(015) 555-2671", "US"},
+	}
+
+	for _, tt := range tests {
+		if p, err := ParsePhoneNumber(tt.in, tt.region); !errors.Is(err, ErrInvalidPhoneNumber) {
+			t.Errorf("ParsePhoneNumber(%q, %q) = %q, %v, want %v", tt.in, tt.region, p, err, ErrInvalidPhoneNumber)
+		}
+	}
+}
+
+func TestPhoneNumber(t *testing.T) {
+	tests := []struct {
+		p                        PhoneNumber
+		channel, number, country string
+		shortCode                bool
+	}{
+		{"+14155552671", "", "+14155552671", "1", false},
+		{"+442079460958", "", "+442079460958", "44", false},
+		{"12345", "", "12345", "", true},
+		{"whatsapp:+6281234567890", "whatsapp", "+6281234567890", "62", false},
+		{"messenger:1234567890", "messenger", "1234567890", "", false},
+	}
+
+	for _, tt := range tests {
+		if c := tt.p.Channel(); c != tt.channel {
+			t.Errorf("PhoneNumber(%q).Channel() = %q, want %q", tt.p, c, tt.channel)
+		}
+
+		if n := tt.p.Number(); n != tt.number {
+			t.Errorf("PhoneNumber(%q).Number() = %q, want %q", tt.p, n, tt.number)
+		}
+
+		if c := tt.p.CountryCode(); c != tt.country {
+			t.Errorf("PhoneNumber(%q).CountryCode() = %q, want %q", tt.p, c, tt.country)
+		}
+
+		if s := tt.p.IsShortCode(); s != tt.shortCode {
+			t.Errorf("PhoneNumber(%q).IsShortCode() = %v, want %v", tt.p, s, tt.shortCode)
+		}
+
+		if err := tt.p.Validate(); err != nil {
+			t.Errorf("PhoneNumber(%q).Validate() returned error: %v", tt.p, err)
+		}
+	}
+}
+
+func TestPhoneNumber_Validate_notNormalized(t *testing.T) {
+	if err := PhoneNumber("+1 415 555 2671").Validate(); !errors.Is(err, ErrInvalidPhoneNumber) {
+		t.Errorf("PhoneNumber.Validate returned %v, want %v", err, ErrInvalidPhoneNumber)
+	}
+}
+
+func TestPhoneNumber_json(t *testing.T) {
+	m := new(Message)
+	if err := json.Unmarshal([]byte(`{"from": "whatsapp:+14155552671", "to": "+15558675309"}`), m); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if m.FromNumber().Channel() != ChannelWhatsApp || m.ToNumber().CountryCode() != "1" {
+		t.Errorf("Message decoded as From %q, To %q", m.From, m.To)
+	}
+}
+
+func TestMessageParams_Validates_phoneNumbers(t *testing.T) {
+	to, _ := ParsePhoneNumber("(555) 867-5309", "US")
+
+	if err := (MessageParams{Body: "Hi", To: to}).Validates(); err != nil {
+		t.Errorf("MessageParams.Validates returned error: %v", err)
+	}
+
+	for _, p := range []MessageParams{
+		{Body: "Hi", To: "555 867 5309"},
+		{Body: "Hi", From: "+1555867530"},
+	} {
+		if err := p.Validates(); !errors.Is(err, ErrInvalidPhoneNumber) {
+			t.Errorf("MessageParams.Validates returned %v, want %v", err, ErrInvalidPhoneNumber)
+		}
+	}
+}
+
+func TestMessageService_Send_phoneNumbers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		if f, to := r.PostForm["From"], r.PostForm["To"]; len(f) != 1 || f[0] != "+14158141829" || len(to) != 1 || to[0] != "+15558675309" {
+			t.Errorf("Request From = %q, To = %q, want params numbers", f, to)
+		}
+
+		fmt.Fprint(w, `{"sid": "SM1234", "from": "+14158141829", "to": "+15558675309"}`)
+	})
+
+	params := MessageParams{Body: "Hello", From: "+14158141829", To: "+15558675309"}
+
+	m, _, err := client.Messages.Send("", "", params)
+	if err != nil {
+		t.Fatalf("Message.Send returned error: %v", err)
+	}
+
+	if m.ToNumber() != params.To {
+		t.Errorf("Message.Send returned To %q, want %q", m.ToNumber(), params.To)
+	}
+}
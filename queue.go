@@ -0,0 +1,149 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+type QueueService struct {
+	client *Client
+}
+
+type Queue struct {
+	Sid             string    `json:"sid"`
+	AccountSid      string    `json:"account_sid"`
+	FriendlyName    string    `json:"friendly_name"`
+	CurrentSize     int       `json:"current_size"`
+	MaxSize         int       `json:"max_size"`
+	AverageWaitTime int       `json:"average_wait_time"`
+	DateCreated     Timestamp `json:"date_created,omitempty"`
+	DateUpdated     Timestamp `json:"date_updated,omitempty"`
+	Uri             string    `json:"uri"`
+}
+
+type QueueParams struct {
+	// A name identifying the queue, used by <Enqueue> and <Queue> TwiML. Required on Create.
+	FriendlyName string
+
+	// Maximum number of calls allowed in the queue, up to 5000. Twilio defaults to 100.
+	MaxSize int
+}
+
+// Maximum value of QueueParams.MaxSize.
+const maxQueueSize = 5000
+
+func (p QueueParams) Validates() error {
+	if p.MaxSize < 0 || p.MaxSize > maxQueueSize {
+		return errors.New(`"MaxSize" must be between 1 and 5000.`)
+	}
+
+	return nil
+}
+
+func (s *QueueService) Create(params QueueParams) (*Queue, *Response, error) {
+	return s.CreateContext(context.Background(), params)
+}
+
+// CreateContext is like Create but bound to ctx.
+func (s *QueueService) CreateContext(ctx context.Context, params QueueParams) (*Queue, *Response, error) {
+	if params.FriendlyName == "" {
+		return nil, nil, errors.New(`"FriendlyName" is required.`)
+	}
+
+	return s.post(ctx, s.client.EndPoint("Queues"), params)
+}
+
+func (s *QueueService) Get(sid string) (*Queue, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *QueueService) GetContext(ctx context.Context, sid string) (*Queue, *Response, error) {
+	u := s.client.EndPoint("Queues", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	q := new(Queue)
+	resp, err := s.client.DoContext(ctx, req, q)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return q, resp, err
+}
+
+// Update changes the name or maximum size of the queue. Blank params are left unchanged.
+func (s *QueueService) Update(sid string, params QueueParams) (*Queue, *Response, error) {
+	return s.UpdateContext(context.Background(), sid, params)
+}
+
+// UpdateContext is like Update but bound to ctx.
+func (s *QueueService) UpdateContext(ctx context.Context, sid string, params QueueParams) (*Queue, *Response, error) {
+	return s.post(ctx, s.client.EndPoint("Queues", sid), params)
+}
+
+func (s *QueueService) post(ctx context.Context, u *url.URL, params QueueParams) (*Queue, *Response, error) {
+	err := params.Validates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := structToUrlValues(&params)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	q := new(Queue)
+	resp, err := s.client.DoContext(ctx, req, q)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return q, resp, err
+}
+
+// Delete removes the queue. Twilio refuses to delete a queue which still has members.
+func (s *QueueService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *QueueService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint("Queues", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
+type QueueListParams struct {
+	PageSize int
+}
+
+func (s *QueueService) List(params QueueListParams) ([]Queue, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *QueueService) ListContext(ctx context.Context, params QueueListParams) ([]Queue, *Response, error) {
+	u := s.client.EndPoint("Queues")
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		Queues []Queue `json:"queues"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Queues, resp, err
+}
@@ -0,0 +1,113 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// QueueMemberService manages calls waiting in a queue. See QueueService.Members.
+type QueueMemberService struct {
+	client   *Client
+	queueSid string
+}
+
+type QueueMember struct {
+	CallSid      string    `json:"call_sid"`
+	QueueSid     string    `json:"queue_sid"`
+	Position     int       `json:"position"`
+	WaitTime     int       `json:"wait_time"`
+	DateEnqueued Timestamp `json:"date_enqueued,omitempty"`
+	Uri          string    `json:"uri"`
+}
+
+// Members returns the service managing members of the queue sid.
+func (s *QueueService) Members(sid string) *QueueMemberService {
+	return &QueueMemberService{client: s.client, queueSid: sid}
+}
+
+func (s *QueueMemberService) endPoint(parts ...string) *url.URL {
+	return s.client.EndPoint(append([]string{"Queues", s.queueSid, "Members"}, parts...)...)
+}
+
+// Get fetches a member by its call sid.
+func (s *QueueMemberService) Get(callSid string) (*QueueMember, *Response, error) {
+	return s.GetContext(context.Background(), callSid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *QueueMemberService) GetContext(ctx context.Context, callSid string) (*QueueMember, *Response, error) {
+	u := s.endPoint(callSid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	m := new(QueueMember)
+	resp, err := s.client.DoContext(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// Front fetches the member at the front of the queue.
+func (s *QueueMemberService) Front() (*QueueMember, *Response, error) {
+	return s.Get("Front")
+}
+
+type QueueMemberListParams struct {
+	PageSize int
+}
+
+func (s *QueueMemberService) List(params QueueMemberListParams) ([]QueueMember, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *QueueMemberService) ListContext(ctx context.Context, params QueueMemberListParams) ([]QueueMember, *Response, error) {
+	u := s.endPoint()
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		QueueMembers []QueueMember `json:"queue_members"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.QueueMembers, resp, err
+}
+
+// Dequeue removes the member from the queue and redirects its call to the TwiML at twimlUrl, requested with method
+// (GET or POST, POST when blank). Use "Front" as callSid to dequeue the member at the front of the queue.
+func (s *QueueMemberService) Dequeue(callSid, twimlUrl, method string) (*QueueMember, *Response, error) {
+	return s.DequeueContext(context.Background(), callSid, twimlUrl, method)
+}
+
+// DequeueContext is like Dequeue but bound to ctx.
+func (s *QueueMemberService) DequeueContext(ctx context.Context, callSid, twimlUrl, method string) (*QueueMember, *Response, error) {
+	v := url.Values{"Url": {twimlUrl}}
+	if method != "" {
+		v.Set("Method", method)
+	}
+
+	u := s.endPoint(callSid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(v.Encode()))
+
+	m := new(QueueMember)
+	resp, err := s.client.DoContext(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
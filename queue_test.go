@@ -0,0 +1,155 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestQueueParams_Validates(t *testing.T) {
+	if err := (QueueParams{MaxSize: 5001}).Validates(); err == nil {
+		t.Error("QueueParams.Validates expected an error to be returned")
+	}
+
+	if err := (QueueParams{MaxSize: 5000}).Validates(); err != nil {
+		t.Errorf("QueueParams.Validates returned error: %v", err)
+	}
+}
+
+func TestQueueService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Queues")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		want := map[string][]string{"FriendlyName": {"support"}, "MaxSize": {"50"}}
+		if !reflect.DeepEqual(map[string][]string(r.PostForm), want) {
+			t.Errorf("Request form = %v, want %v", r.PostForm, want)
+		}
+
+		fmt.Fprint(w, `{"sid": "QU1234", "friendly_name": "support", "max_size": 50, "current_size": 0, "average_wait_time": 0}`)
+	})
+
+	q, _, err := client.Queues.Create(QueueParams{FriendlyName: "support", MaxSize: 50})
+	if err != nil {
+		t.Errorf("Queue.Create returned error: %v", err)
+	}
+
+	want := &Queue{Sid: "QU1234", FriendlyName: "support", MaxSize: 50}
+	if !reflect.DeepEqual(q, want) {
+		t.Errorf("Queue.Create returned %+v, want %+v", q, want)
+	}
+
+	if _, _, err := client.Queues.Create(QueueParams{}); err == nil {
+		t.Error("Queue.Create without FriendlyName expected an error to be returned")
+	}
+}
+
+func TestQueueService_GetUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Queues", "QU1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"sid": "QU1234", "current_size": 3, "average_wait_time": 42}`)
+		case "POST":
+			r.ParseForm()
+			fmt.Fprintf(w, `{"sid": "QU1234", "max_size": %s}`, r.PostForm.Get("MaxSize"))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	q, _, err := client.Queues.Get("QU1234")
+	if err != nil {
+		t.Errorf("Queue.Get returned error: %v", err)
+	}
+
+	want := &Queue{Sid: "QU1234", CurrentSize: 3, AverageWaitTime: 42}
+	if !reflect.DeepEqual(q, want) {
+		t.Errorf("Queue.Get returned %+v, want %+v", q, want)
+	}
+
+	q, _, err = client.Queues.Update("QU1234", QueueParams{MaxSize: 10})
+	if err != nil || q.MaxSize != 10 {
+		t.Errorf("Queue.Update returned %+v, %v", q, err)
+	}
+
+	if _, err := client.Queues.Delete("QU1234"); err != nil {
+		t.Errorf("Queue.Delete returned error: %v", err)
+	}
+}
+
+func TestQueueService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Queues")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": 0, "queues": [{"sid": "QU1234"}]}`)
+	})
+
+	ql, _, err := client.Queues.List(QueueListParams{})
+	if err != nil {
+		t.Errorf("Queue.List returned error: %v", err)
+	}
+
+	want := []Queue{{Sid: "QU1234"}}
+	if !reflect.DeepEqual(ql, want) {
+		t.Errorf("Queue.List returned %+v, want %+v", ql, want)
+	}
+}
+
+func TestQueueMemberService(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(client.EndPoint("Queues", "QU1234", "Members").String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": 0, "queue_members": [{"call_sid": "CA1234", "position": 1, "wait_time": 30}]}`)
+	})
+
+	mux.HandleFunc(client.EndPoint("Queues", "QU1234", "Members", "Front").String(), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"call_sid": "CA1234", "position": 1}`)
+		case "POST":
+			r.ParseForm()
+			if r.PostForm.Get("Url") != "http://example.com/agent" || r.PostForm.Get("Method") != "GET" {
+				t.Errorf("Request form = %v", r.PostForm)
+			}
+			fmt.Fprint(w, `{"call_sid": "CA1234", "position": 1}`)
+		}
+	})
+
+	s := client.Queues.Members("QU1234")
+
+	ml, _, err := s.List(QueueMemberListParams{})
+	if err != nil {
+		t.Errorf("QueueMember.List returned error: %v", err)
+	}
+
+	want := []QueueMember{{CallSid: "CA1234", Position: 1, WaitTime: 30}}
+	if !reflect.DeepEqual(ml, want) {
+		t.Errorf("QueueMember.List returned %+v, want %+v", ml, want)
+	}
+
+	m, _, err := s.Front()
+	if err != nil || m.CallSid != "CA1234" {
+		t.Errorf("QueueMember.Front returned %+v, %v", m, err)
+	}
+
+	if _, _, err := s.Dequeue("Front", "http://example.com/agent", "GET"); err != nil {
+		t.Errorf("QueueMember.Dequeue returned error: %v", err)
+	}
+}
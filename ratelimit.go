@@ -0,0 +1,154 @@
+package twilio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a client-side token bucket limiter for outbound requests. Set Client.RateLimiter to enable it.
+//
+// Twilio enforces send rates per sender (eg: 1 message per second for long codes), so requests are usually
+// bucketed by their sender:
+//
+//	c.RateLimiter = twilio.NewRateLimiter(1, 1)
+//	c.RateLimiter.Key = twilio.SenderKey
+type RateLimiter struct {
+	// Number of requests per second allowed for each bucket. Zero or less disables limiting.
+	Rate float64
+
+	// Maximum number of requests a bucket may send at once. Values below 1 are treated as 1.
+	Burst int
+
+	// Key selects the bucket of a request from its form values. Requests for which Key returns ""
+	// are not limited. A nil Key puts every request in a single bucket.
+	Key func(v url.Values) string
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rate requests per second with the given burst, shared by all requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{Rate: rate, Burst: burst}
+}
+
+// SenderKey buckets requests by their "MessagingServiceSid" or, when it's not set, their "From" value.
+func SenderKey(v url.Values) string {
+	if s := v.Get("MessagingServiceSid"); s != "" {
+		return s
+	}
+
+	return v.Get("From")
+}
+
+// Wait blocks until the bucket identified by key has a free slot or ctx is done. It returns how long it waited.
+func (l *RateLimiter) Wait(ctx context.Context, key string) (time.Duration, error) {
+	d := l.reserve(key, time.Now())
+	if d <= 0 {
+		return 0, nil
+	}
+
+	if err := sleepContext(ctx, d); err != nil {
+		l.cancel(key)
+		return 0, err
+	}
+
+	return d, nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *RateLimiter) reserve(key string, now time.Time) time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	if l.buckets == nil {
+		l.buckets = map[string]*bucket{}
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[key] = b
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * l.Rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / l.Rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve that won't be used.
+func (l *RateLimiter) cancel(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		b.tokens++
+	}
+}
+
+// wait applies the limiter to req. Requests not selected by Key pass through immediately.
+func (l *RateLimiter) wait(ctx context.Context, req *http.Request) (time.Duration, error) {
+	if l == nil {
+		return 0, nil
+	}
+
+	key := ""
+	if l.Key != nil {
+		if key = l.Key(requestForm(req)); key == "" {
+			return 0, nil
+		}
+	}
+
+	return l.Wait(ctx, key)
+}
+
+// requestForm returns url-encoded form values sent in req body without consuming it.
+func requestForm(req *http.Request) url.Values {
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return url.Values{}
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return url.Values{}
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return url.Values{}
+	}
+
+	v, _ := url.ParseQuery(string(b))
+	return v
+}
@@ -0,0 +1,75 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := NewRateLimiter(2, 2)
+	now := time.Now()
+
+	assert.Equal(t, l.reserve("a", now), time.Duration(0))
+	assert.Equal(t, l.reserve("a", now), time.Duration(0))
+	assert.Equal(t, l.reserve("a", now), 500*time.Millisecond)
+	assert.Equal(t, l.reserve("a", now), time.Second)
+
+	// buckets are independent
+	assert.Equal(t, l.reserve("b", now), time.Duration(0))
+
+	// tokens are refilled over time
+	assert.Equal(t, l.reserve("a", now.Add(time.Second)), 500*time.Millisecond)
+}
+
+func TestRateLimiter_Wait_canceled(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+
+	_, err := l.Wait(context.Background(), "")
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = l.Wait(ctx, "")
+	assert.Equal(t, err, context.DeadlineExceeded)
+}
+
+func TestSenderKey(t *testing.T) {
+	assert.Equal(t, SenderKey(url.Values{"From": {"+14158141829"}}), "+14158141829")
+	assert.Equal(t, SenderKey(url.Values{"From": {"+14158141829"}, "MessagingServiceSid": {"MG123"}}), "MG123")
+	assert.Equal(t, SenderKey(url.Values{}), "")
+}
+
+func TestMessageService_Send_rateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RateLimiter = NewRateLimiter(50, 1)
+	client.RateLimiter.Key = SenderKey
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sid": "abcdef"}`)
+	})
+
+	params := MessageParams{Body: "Hello"}
+
+	_, r, err := client.Messages.Send("+14158141829", "+15558675309", params)
+	assert.Nil(t, err)
+	assert.Equal(t, r.RateLimitWait, time.Duration(0))
+
+	_, r, err = client.Messages.Send("+14158141829", "+15558675309", params)
+	assert.Nil(t, err)
+	assert.True(t, r.RateLimitWait > 0)
+
+	_, r, err = client.Messages.Send("+14158141830", "+15558675309", params)
+	assert.Nil(t, err)
+	assert.Equal(t, r.RateLimitWait, time.Duration(0))
+}
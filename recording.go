@@ -0,0 +1,140 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// RecordingService manages recordings, either of the whole account (Client.Recordings)
+// or of a single call (see CallService.Recordings).
+type RecordingService struct {
+	client *Client
+
+	// Endpoint path parts leading to the recordings list, eg: "Calls", "CA123", "Recordings".
+	parts []string
+}
+
+type Recording struct {
+	Sid           string    `json:"sid"`
+	AccountSid    string    `json:"account_sid"`
+	CallSid       string    `json:"call_sid"`
+	ConferenceSid string    `json:"conference_sid"`
+	ApiVersion    string    `json:"api_version"`
+	DateCreated   Timestamp `json:"date_created,omitempty"`
+	DateUpdated   Timestamp `json:"date_updated,omitempty"`
+	StartTime     Timestamp `json:"start_time,omitempty"`
+	Duration      int       `json:"duration,string"`
+	Channels      int       `json:"channels"`
+	Source        string    `json:"source"`
+	Status        string    `json:"status"`
+	ErrorCode     ErrorCode `json:"error_code"`
+	Price         Price     `json:"price,omitempty"`
+	PriceUnit     string    `json:"price_unit"`
+	Uri           string    `json:"uri"`
+}
+
+// Recordings returns the service managing recordings of the call sid.
+func (s *CallService) Recordings(sid string) *RecordingService {
+	return &RecordingService{client: s.client, parts: []string{"Calls", sid, "Recordings"}}
+}
+
+func (s *RecordingService) endPoint(parts ...string) []string {
+	return append(append([]string{}, s.parts...), parts...)
+}
+
+func (s *RecordingService) Get(sid string) (*Recording, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *RecordingService) GetContext(ctx context.Context, sid string) (*Recording, *Response, error) {
+	u := s.client.EndPoint(s.endPoint(sid)...)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	r := new(Recording)
+	resp, err := s.client.DoContext(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+type RecordingListParams struct {
+	CallSid       string
+	ConferenceSid string
+
+	// Dates are formatted as YYYY-MM-DD.
+	DateCreated       string
+	DateCreatedAfter  string `form:"DateCreated>"`
+	DateCreatedBefore string `form:"DateCreated<"`
+
+	PageSize int
+}
+
+func (s *RecordingService) List(params RecordingListParams) ([]Recording, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *RecordingService) ListContext(ctx context.Context, params RecordingListParams) ([]Recording, *Response, error) {
+	u := s.client.EndPoint(s.endPoint()...)
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		Recordings []Recording `json:"recordings"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Recordings, resp, err
+}
+
+func (s *RecordingService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *RecordingService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint(s.endPoint(sid)...)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
+
+// Download streams the recording audio, either as "wav" or "mp3". The caller must close the returned body.
+func (s *RecordingService) Download(sid, format string) (io.ReadCloser, *Response, error) {
+	return s.DownloadContext(context.Background(), sid, format)
+}
+
+// DownloadContext is like Download but bound to ctx.
+func (s *RecordingService) DownloadContext(ctx context.Context, sid, format string) (io.ReadCloser, *Response, error) {
+	if format != "wav" && format != "mp3" {
+		return nil, nil, fmt.Errorf(`Recording format must be either "wav" or "mp3", got %q.`, format)
+	}
+
+	// media is served from the account level endpoint only
+	u := s.client.endPointWithFormat(format, "Recordings", sid)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	req.Header.Set("Accept", "audio/*")
+
+	resp, err := s.client.DoStream(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
@@ -0,0 +1,196 @@
+package twilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRecordingService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Recordings", "RE1234")
+
+	output := `{
+		"sid": "RE1234",
+		"call_sid": "CA1234",
+		"duration": "6",
+		"channels": 1,
+		"status": "completed",
+		"error_code": null,
+		"price": "-0.0025",
+		"date_created": "Wed, 18 Aug 2010 20:01:40 +0000"
+	}`
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, output)
+	})
+
+	rc, _, err := client.Recordings.Get("RE1234")
+	if err != nil {
+		t.Errorf("Recording.Get returned error: %v", err)
+	}
+
+	want := &Recording{
+		Sid:         "RE1234",
+		CallSid:     "CA1234",
+		Duration:    6,
+		Channels:    1,
+		Status:      "completed",
+		Price:       -0.0025,
+		DateCreated: parseTimestamp("Wed, 18 Aug 2010 20:01:40 +0000"),
+	}
+
+	if !reflect.DeepEqual(rc, want) {
+		t.Errorf("Recording.Get returned %+v, want %+v", rc, want)
+	}
+}
+
+func TestRecordingService_List_call(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Calls", "CA1234", "Recordings")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if d := r.URL.Query().Get("DateCreated>"); d != "2014-01-01" {
+			t.Errorf("Request DateCreated> = %q, want %q", d, "2014-01-01")
+		}
+
+		fmt.Fprint(w, `{"page": 0, "recordings": [{"sid": "RE1234"}]}`)
+	})
+
+	rl, _, err := client.Calls.Recordings("CA1234").List(RecordingListParams{DateCreatedAfter: "2014-01-01"})
+	if err != nil {
+		t.Errorf("Recording.List returned error: %v", err)
+	}
+
+	want := []Recording{{Sid: "RE1234"}}
+	if !reflect.DeepEqual(rl, want) {
+		t.Errorf("Recording.List returned %+v, want %+v", rl, want)
+	}
+}
+
+func TestRecordingService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Recordings", "RE1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Recordings.Delete("RE1234")
+	if err != nil {
+		t.Errorf("Recording.Delete returned error: %v", err)
+	}
+}
+
+func TestRecordingService_Download(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/2010-04-01/Accounts/AC5ef87/Recordings/RE1234.mp3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if r.Header.Get("Authorization") != encodeAuth() {
+			t.Error("Download request should be authenticated")
+		}
+
+		w.Header().Set("Content-Type", "audio/mpeg")
+		fmt.Fprint(w, "ID3")
+	})
+
+	body, r, err := client.Calls.Recordings("CA1234").Download("RE1234", "mp3")
+	if err != nil {
+		t.Fatalf("Recording.Download returned error: %v", err)
+	}
+	defer body.Close()
+
+	b, _ := ioutil.ReadAll(body)
+	if string(b) != "ID3" {
+		t.Errorf("Recording.Download returned %q, want %q", b, "ID3")
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "audio/mpeg" {
+		t.Errorf("Recording.Download content type = %q, want %q", ct, "audio/mpeg")
+	}
+}
+
+func TestRecordingService_Download_errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/2010-04-01/Accounts/AC5ef87/Recordings/RE0000.wav", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": 404, "code": 20404, "message": "The requested resource was not found"}`)
+	})
+
+	if _, _, err := client.Recordings.Download("RE0000", "wav"); err == nil {
+		t.Error("Expected HTTP 404 error.")
+	}
+
+	if _, _, err := client.Recordings.Download("RE0000", "ogg"); err == nil {
+		t.Error("Expected unsupported format error.")
+	}
+}
+
+func TestTranscriptionService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Recordings", "RE1234", "Transcriptions")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"page": 0, "transcriptions": [{"sid": "TR1234", "recording_sid": "RE1234", "transcription_text": "Hello", "duration": "2"}]}`)
+	})
+
+	tl, _, err := client.Recordings.Transcriptions("RE1234").List(TranscriptionListParams{})
+	if err != nil {
+		t.Errorf("Transcription.List returned error: %v", err)
+	}
+
+	want := []Transcription{{Sid: "TR1234", RecordingSid: "RE1234", TranscriptionText: "Hello", Duration: 2}}
+	if !reflect.DeepEqual(tl, want) {
+		t.Errorf("Transcription.List returned %+v, want %+v", tl, want)
+	}
+}
+
+func TestTranscriptionService_GetDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Transcriptions", "TR1234")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		fmt.Fprint(w, `{"sid": "TR1234", "status": "completed"}`)
+	})
+
+	tr, _, err := client.Transcriptions.Get("TR1234")
+	if err != nil {
+		t.Errorf("Transcription.Get returned error: %v", err)
+	}
+
+	want := &Transcription{Sid: "TR1234", Status: "completed"}
+	if !reflect.DeepEqual(tr, want) {
+		t.Errorf("Transcription.Get returned %+v, want %+v", tr, want)
+	}
+
+	if _, err := client.Transcriptions.Delete("TR1234"); err != nil {
+		t.Errorf("Transcription.Delete returned error: %v", err)
+	}
+}
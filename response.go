@@ -2,15 +2,22 @@ package twilio
 
 import (
 	"net/http"
+	"time"
 )
 
 // Wraps http.Response. So we can add more functionalities later.
 type Response struct {
 	*http.Response
 	Pagination
+
+	// Number of attempts made to get this response, including retries.
+	Attempts int
+
+	// Time spent waiting for Client.RateLimiter before sending the request.
+	RateLimitWait time.Duration
 }
 
 func NewResponse(r *http.Response) *Response {
-	response := &Response{Response: r}
+	response := &Response{Response: r, Attempts: 1}
 	return response
 }
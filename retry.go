@@ -0,0 +1,154 @@
+package twilio
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client retries requests answered with 429 (Too Many Requests) or 5xx responses.
+// Set Client.RetryPolicy to enable it; a nil policy means every request is sent exactly once.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values below 2 disable retrying.
+	MaxAttempts int
+
+	// Delay before the first retry. It's doubled on every following retry.
+	BaseDelay time.Duration
+
+	// Upper bound of the delay between attempts, including delays requested through Retry-After.
+	// Zero means no upper bound.
+	MaxDelay time.Duration
+
+	// Fraction (0 to 1) of the computed delay that is randomized, to avoid retrying in lockstep.
+	Jitter float64
+
+	// By default only idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried.
+	// Set RetryPost to retry POST requests as well.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns a policy with sensible defaults: 3 attempts, 500ms base delay, 10s max delay and 20% jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// shouldRetry reports whether req may be sent again after receiving resp on the given attempt.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	if !isRetryableStatus(resp.StatusCode) {
+		return false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	case "POST":
+		return p.RetryPost
+	}
+
+	return false
+}
+
+// delay returns how long to wait after the given attempt. Retry-After header on resp takes precedence over the backoff.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return p.capDelay(d)
+	}
+
+	d := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		d -= d * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(d)
+}
+
+func (p *RetryPolicy) capDelay(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+
+	return d
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || (500 <= code && code <= 599)
+}
+
+// retryAfter parses Retry-After header, which is either delay in seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+
+	if n, err := strconv.Atoi(h); err == nil && n >= 0 {
+		return time.Duration(n) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(h); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// rewindRequest returns a copy of req with a fresh body, ready to be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// discardResponse drains and closes the body, so the underlying connection can be reused.
+func discardResponse(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+package twilio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"status": 503, "code": 20503, "message": "Service unavailable"}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"Bar":"bar"}`)
+	})
+
+	type foo struct {
+		Bar string
+	}
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	body := new(foo)
+	resp, err := client.Do(req, body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, resp.Attempts, 3)
+	assert.Equal(t, body, &foo{"bar"})
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"status": 429, "code": 20429, "message": "Too Many Requests"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(req, nil)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, resp.Attempts, 2)
+	assert.Equal(t, err.(*Exception).Code, 20429)
+}
+
+func TestDo_retryPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("POST", "/", strings.NewReader("Body=hello"))
+	resp, _ := client.Do(req, nil)
+	assert.Equal(t, resp.Attempts, 1)
+
+	client.RetryPolicy.RetryPost = true
+	bodies = nil
+
+	req, _ = client.NewRequest("POST", "/", strings.NewReader("Body=hello"))
+	resp, _ = client.Do(req, nil)
+	assert.Equal(t, resp.Attempts, 3)
+	assert.Equal(t, bodies, []string{"Body=hello", "Body=hello", "Body=hello"})
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	resp := &http.Response{Header: http.Header{}}
+
+	assert.Equal(t, p.delay(resp, 1), 100*time.Millisecond)
+	assert.Equal(t, p.delay(resp, 2), 200*time.Millisecond)
+	assert.Equal(t, p.delay(resp, 3), 300*time.Millisecond)
+
+	resp.Header.Set("Retry-After", "0")
+	assert.Equal(t, p.delay(resp, 3), time.Duration(0))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, p.delay(resp, 1), 300*time.Millisecond)
+}
+
+func TestRetryPolicy_delayJitter(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, Jitter: 0.5}
+	resp := &http.Response{Header: http.Header{}}
+
+	for i := 0; i < 10; i++ {
+		d := p.delay(resp, 1)
+		assert.True(t, 50*time.Millisecond <= d && d <= 100*time.Millisecond)
+	}
+}
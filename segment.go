@@ -0,0 +1,166 @@
+package twilio
+
+import (
+	"strings"
+)
+
+// Encoding is the character encoding a message body is sent with.
+type Encoding string
+
+const (
+	EncodingGSM7 Encoding = "GSM-7"
+	EncodingUCS2 Encoding = "UCS-2"
+)
+
+// Characters of the GSM 03.38 default alphabet, taking one septet each.
+const gsm7Chars = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// Characters of the GSM 03.38 extension table, taking two septets each since they're escaped.
+const gsm7ExtChars = "\f^{}\\[~]|€"
+
+// smartEncodings lists the Unicode characters replaced by a GSM-7 look-alike when "SmartEncoded" is enabled.
+var smartEncodings = map[rune]string{
+	'«': `"`, '»': `"`, '“': `"`, '”': `"`, '„': `"`, '″': `"`, 'ʺ': `"`, '＂': `"`,
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", 'ʹ': "'", 'ʼ': "'", 'ʽ': "'", '＇': "'",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-", '\u00ad': "-",
+	'…': "...", '‹': "<", '›': ">", '⁄': "/", '∕': "/", 'ˆ': "^", '˜': "~", '¦': "|",
+
+	// spaces, and zero width characters which are dropped
+	'\u00a0': " ", '\u2000': " ", '\u2001': " ", '\u2002': " ", '\u2003': " ", '\u2004': " ",
+	'\u2005': " ", '\u2006': " ", '\u2007': " ", '\u2008': " ", '\u2009': " ", '\u200a': " ",
+	'\u202f': " ", '\u205f': " ", '\u3000': " ",
+	'\u200b': "", '\u2060': "", '\ufeff': "",
+}
+
+// Segment is a part of a message body sent as a single SMS.
+type Segment struct {
+	// Offsets of the first and past the last character of the segment in the body, counted in runes.
+	Start int
+	End   int
+
+	// Text of the segment.
+	Text string
+
+	// Length of the segment in septets for GSM-7, or UTF-16 code units for UCS-2.
+	Units int
+}
+
+// SegmentInfo describes how a message body is split into SMS segments.
+type SegmentInfo struct {
+	Encoding Encoding
+
+	// The body as sent, after smart encoding substitutions.
+	Body string
+
+	// Characters outside of the GSM-7 charset which forced UCS-2, in order of appearance.
+	UnicodeChars []rune
+
+	Segments []Segment
+}
+
+// NumSegments returns the number of SMS the body is billed as.
+func (i SegmentInfo) NumSegments() int {
+	return len(i.Segments)
+}
+
+// CalculateSegments splits body into SMS segments, the same way carriers do, without calling the API.
+// Bodies made of GSM-7 characters take up to 160 characters in a single SMS, 153 per segment otherwise,
+// characters of the extension table counting as two. Any other character forces UCS-2, taking up to 70
+// characters in a single SMS, 67 per segment otherwise. When smartEncoded is true, Unicode characters having
+// a GSM-7 look-alike are replaced first, as Twilio does for messages sent with "SmartEncoded".
+//
+//	info := twilio.CalculateSegments("Hello “world”", true)
+//	if info.Encoding == twilio.EncodingUCS2 {
+//		// warn about info.UnicodeChars
+//	}
+func CalculateSegments(body string, smartEncoded bool) SegmentInfo {
+	if smartEncoded {
+		body = smartEncode(body)
+	}
+
+	info := SegmentInfo{Encoding: EncodingGSM7, Body: body}
+
+	seen := map[rune]bool{}
+	for _, r := range body {
+		if gsm7Units(r) == 0 && !seen[r] {
+			seen[r] = true
+			info.UnicodeChars = append(info.UnicodeChars, r)
+		}
+	}
+
+	units := gsm7Units
+	single, multi := 160, 153
+	if len(info.UnicodeChars) > 0 {
+		info.Encoding = EncodingUCS2
+		units = ucs2Units
+		single, multi = 70, 67
+	}
+
+	runes := []rune(body)
+
+	total := 0
+	for _, r := range runes {
+		total += units(r)
+	}
+
+	if total <= single {
+		if len(runes) > 0 {
+			info.Segments = []Segment{{Start: 0, End: len(runes), Text: body, Units: total}}
+		}
+		return info
+	}
+
+	// characters are never split across segments, which may leave a segment one unit short
+	seg := Segment{}
+	for i, r := range runes {
+		n := units(r)
+		if seg.Units+n > multi {
+			seg.End = i
+			seg.Text = string(runes[seg.Start:i])
+			info.Segments = append(info.Segments, seg)
+			seg = Segment{Start: i}
+		}
+		seg.Units += n
+	}
+
+	seg.End = len(runes)
+	seg.Text = string(runes[seg.Start:])
+	info.Segments = append(info.Segments, seg)
+
+	return info
+}
+
+// gsm7Units returns the number of septets r takes in GSM-7, or 0 when it's not part of the charset.
+func gsm7Units(r rune) int {
+	switch {
+	case strings.ContainsRune(gsm7Chars, r):
+		return 1
+	case strings.ContainsRune(gsm7ExtChars, r):
+		return 2
+	}
+
+	return 0
+}
+
+// ucs2Units returns the number of UTF-16 code units r takes, characters outside of the BMP taking a surrogate pair.
+func ucs2Units(r rune) int {
+	if r > 0xffff {
+		return 2
+	}
+
+	return 1
+}
+
+func smartEncode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if sub, ok := smartEncodings[r]; ok {
+			b.WriteString(sub)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
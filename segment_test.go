@@ -0,0 +1,78 @@
+package twilio
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCalculateSegments(t *testing.T) {
+	tests := []struct {
+		body     string
+		smart    bool
+		encoding Encoding
+		segments []int
+	}{
+		{"", false, EncodingGSM7, nil},
+		{"Hello!", false, EncodingGSM7, []int{6}},
+		{strings.Repeat("a", 160), false, EncodingGSM7, []int{160}},
+		{strings.Repeat("a", 161), false, EncodingGSM7, []int{153, 8}},
+		{strings.Repeat("€", 80), false, EncodingGSM7, []int{160}},
+		{strings.Repeat("a", 152) + "€" + strings.Repeat("a", 10), false, EncodingGSM7, []int{152, 12}},
+		{strings.Repeat("é", 70), false, EncodingGSM7, []int{70}},
+		{strings.Repeat("ç", 70), false, EncodingUCS2, []int{70}},
+		{strings.Repeat("ç", 71), false, EncodingUCS2, []int{67, 4}},
+		{strings.Repeat("a", 66) + "😀" + strings.Repeat("a", 5), false, EncodingUCS2, []int{66, 7}},
+		{"It’s “smart”…", true, EncodingGSM7, []int{15}},
+		{"It’s “smart”…", false, EncodingUCS2, []int{13}},
+	}
+
+	for i, tt := range tests {
+		info := CalculateSegments(tt.body, tt.smart)
+
+		if info.Encoding != tt.encoding {
+			t.Errorf("%d. CalculateSegments encoding = %s, want %s", i, info.Encoding, tt.encoding)
+		}
+
+		var units []int
+		text := ""
+		for _, s := range info.Segments {
+			units = append(units, s.Units)
+			text += s.Text
+		}
+
+		if !reflect.DeepEqual(units, tt.segments) {
+			t.Errorf("%d. CalculateSegments segments = %v, want %v", i, units, tt.segments)
+		}
+
+		if text != info.Body {
+			t.Errorf("%d. CalculateSegments segments text = %q, want %q", i, text, info.Body)
+		}
+	}
+}
+
+func TestCalculateSegments_unicodeChars(t *testing.T) {
+	info := CalculateSegments("Olá Ελλάδα, olá", false)
+
+	want := []rune{'á', 'Ε', 'λ', 'ά', 'δ', 'α'}
+	if !reflect.DeepEqual(info.UnicodeChars, want) {
+		t.Errorf("CalculateSegments unicode chars = %q, want %q", info.UnicodeChars, want)
+	}
+
+	if info.NumSegments() != 1 {
+		t.Errorf("CalculateSegments returned %d segments, want 1", info.NumSegments())
+	}
+}
+
+func TestCalculateSegments_boundaries(t *testing.T) {
+	info := CalculateSegments(strings.Repeat("a", 200), false)
+
+	want := []Segment{
+		{Start: 0, End: 153, Text: strings.Repeat("a", 153), Units: 153},
+		{Start: 153, End: 200, Text: strings.Repeat("a", 47), Units: 47},
+	}
+
+	if !reflect.DeepEqual(info.Segments, want) {
+		t.Errorf("CalculateSegments returned %+v, want %+v", info.Segments, want)
+	}
+}
@@ -0,0 +1,147 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SendSplit is like Send, but bodies longer than MaxBodyLength are split at word boundaries into numbered parts,
+// eg: "(1/3) ...", which are sent in order with the same from and to. Media are attached to the first part only.
+//
+// Sending stops at the first part which fails, so the recipient never gets parts out of order. It returns
+// the messages of the parts sent before, along with a *SplitError telling which parts were not sent.
+func (s *MessageService) SendSplit(from, to string, params MessageParams) ([]*Message, error) {
+	return s.SendSplitContext(context.Background(), from, to, params)
+}
+
+// SendSplitContext is like SendSplit but bound to ctx.
+func (s *MessageService) SendSplitContext(ctx context.Context, from, to string, params MessageParams) ([]*Message, error) {
+	parts, err := SplitBody(params.Body, MaxBodyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) == 0 {
+		// nothing to split, eg: a media only message
+		p := params
+		p.Body = ""
+
+		m, _, err := s.SendContext(ctx, from, to, p)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*Message{m}, nil
+	}
+
+	var messages []*Message
+
+	for i, body := range parts {
+		p := params
+		p.Body = body
+		if i > 0 {
+			p.MediaUrl = nil
+		}
+
+		m, _, err := s.SendContext(ctx, from, to, p)
+		if err != nil {
+			return messages, &SplitError{Part: i + 1, Unsent: parts[i:], Err: err}
+		}
+
+		messages = append(messages, m)
+	}
+
+	return messages, nil
+}
+
+// SplitError is returned by MessageService.SendSplit when a part couldn't be sent.
+type SplitError struct {
+	// Number of the part which failed, starting at 1.
+	Part int
+
+	// Bodies of the failed part and the following ones, which were not sent. Send them in order to complete
+	// the message.
+	Unsent []string
+
+	Err error
+}
+
+func (e *SplitError) Error() string {
+	total := e.Part - 1 + len(e.Unsent)
+	return fmt.Sprintf("twilio: part %d/%d: %v; parts %d to %d not sent", e.Part, total, e.Err, e.Part, total)
+}
+
+func (e *SplitError) Unwrap() error {
+	return e.Err
+}
+
+// SplitBody splits body in parts of at most limit characters, numbered as "(1/3) ", cutting at word boundaries
+// when possible. A body which fits in limit is returned as is, and one which fits once its surrounding spaces are
+// trimmed is returned trimmed and unnumbered. A blank body gives no parts. It fails when limit is too small to hold
+// the numbering along with at least one character of body.
+func SplitBody(body string, limit int) ([]string, error) {
+	if utf8.RuneCountInString(body) <= limit {
+		if body == "" {
+			return nil, nil
+		}
+		return []string{body}, nil
+	}
+
+	// surrounding spaces are dropped rather than sent as parts of their own
+	body = strings.TrimSpace(body)
+	if utf8.RuneCountInString(body) <= limit {
+		if body == "" {
+			return nil, nil
+		}
+		return []string{body}, nil
+	}
+
+	// the prefix length depends on the number of parts, so split again until the count settles
+	n := 2
+	for {
+		width := limit - len(fmt.Sprintf("(%d/%d) ", n, n))
+		if width < 1 {
+			return nil, fmt.Errorf("twilio: split limit %d is too small to number %d parts", limit, n)
+		}
+
+		words := splitWords(body, width)
+		if len(words) <= n || len(fmt.Sprint(len(words))) == len(fmt.Sprint(n)) {
+			parts := make([]string, len(words))
+			for i, w := range words {
+				parts[i] = fmt.Sprintf("(%d/%d) %s", i+1, len(words), w)
+			}
+			return parts, nil
+		}
+
+		n = len(words)
+	}
+}
+
+// splitWords splits s in chunks of at most width characters, cutting at the last space of each chunk. Words longer
+// than width are cut where they overflow.
+func splitWords(s string, width int) []string {
+	var chunks []string
+
+	runes := []rune(strings.TrimSpace(s))
+	for len(runes) > width {
+		cut := width
+		for j := width; j > 0; j-- {
+			if unicode.IsSpace(runes[j]) {
+				cut = j
+				break
+			}
+		}
+
+		chunks = append(chunks, strings.TrimRightFunc(string(runes[:cut]), unicode.IsSpace))
+		runes = []rune(strings.TrimLeftFunc(string(runes[cut:]), unicode.IsSpace))
+	}
+
+	if len(runes) > 0 {
+		chunks = append(chunks, string(runes))
+	}
+
+	return chunks
+}
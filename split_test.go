@@ -0,0 +1,207 @@
+package twilio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitBody(t *testing.T) {
+	tests := []struct {
+		body  string
+		limit int
+		want  []string
+	}{
+		{"", 20, nil},
+		{"short enough", 20, []string{"short enough"}},
+		{strings.Repeat(" ", 50), 20, nil},
+		{"a" + strings.Repeat(" ", 50), 20, []string{"a"}},
+		{"  short enough  ", 12, []string{"short enough"}},
+		{strings.Repeat(" ", 1700), MaxBodyLength, nil},
+		{"a" + strings.Repeat(" ", 1600), MaxBodyLength, []string{"a"}},
+		{"the quick brown fox jumps over the lazy dog", 20, []string{
+			"(1/4) the quick",
+			"(2/4) brown fox",
+			"(3/4) jumps over the",
+			"(4/4) lazy dog",
+		}},
+		{"abcdefghijklmnopqrstuvwxyz", 14, []string{
+			"(1/4) abcdefgh",
+			"(2/4) ijklmnop",
+			"(3/4) qrstuvwx",
+			"(4/4) yz",
+		}},
+	}
+
+	for i, tt := range tests {
+		got, err := SplitBody(tt.body, tt.limit)
+		if err != nil {
+			t.Errorf("%d. SplitBody returned error: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%d. SplitBody returned %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestSplitBody_manyParts(t *testing.T) {
+	body := strings.TrimSpace(strings.Repeat("word ", 200))
+
+	parts, err := SplitBody(body, 30)
+	if err != nil {
+		t.Fatalf("SplitBody returned error: %v", err)
+	}
+
+	for _, p := range parts {
+		if n := len([]rune(p)); n > 30 {
+			t.Errorf("SplitBody returned a part of %d characters: %q", n, p)
+		}
+	}
+
+	if last := parts[len(parts)-1]; !strings.HasPrefix(last, fmt.Sprintf("(%d/%d) ", len(parts), len(parts))) {
+		t.Errorf("SplitBody last part = %q, want numbered %d/%d", last, len(parts), len(parts))
+	}
+}
+
+func TestSplitBody_limitTooSmall(t *testing.T) {
+	for _, limit := range []int{5, 7} {
+		if parts, err := SplitBody(strings.Repeat("a", 50), limit); err == nil {
+			t.Errorf("SplitBody with limit %d returned %q, want an error", limit, parts)
+		}
+	}
+
+	parts, err := SplitBody(strings.Repeat("a", 9), 7)
+	if err != nil {
+		t.Fatalf("SplitBody returned error: %v", err)
+	}
+
+	for _, p := range parts {
+		if len(p) > 7 {
+			t.Errorf("SplitBody returned a part of %d characters: %q", len(p), p)
+		}
+	}
+}
+
+func TestMessageParams_Validates_bodyTooLong(t *testing.T) {
+	p := MessageParams{Body: strings.Repeat("a", MaxBodyLength+1)}
+	if err := p.Validates(); err == nil {
+		t.Error("MessageParams.Validates expected an error to be returned")
+	}
+}
+
+func TestMessageService_SendSplit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	var bodies []string
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+
+		bodies = append(bodies, r.PostForm.Get("Body"))
+		if len(bodies) > 1 && r.PostForm.Get("MediaUrl") != "" {
+			t.Errorf("Request MediaUrl = %q on part %d, want none", r.PostForm.Get("MediaUrl"), len(bodies))
+		}
+
+		fmt.Fprintf(w, `{"sid": "SM%d"}`, len(bodies))
+	})
+
+	params := MessageParams{
+		Body:     strings.Repeat("lorem ipsum ", 300),
+		MediaUrl: []string{"http://www.example.com/hearts.png"},
+	}
+
+	ms, err := client.Messages.SendSplit("+14158141829", "+15558675309", params)
+	if err != nil {
+		t.Fatalf("Message.SendSplit returned error: %v", err)
+	}
+
+	if len(bodies) != 3 || !strings.HasPrefix(bodies[0], "(1/3) ") || !strings.HasPrefix(bodies[2], "(3/3) ") {
+		t.Errorf("Message.SendSplit sent %d parts, want 3 numbered parts", len(bodies))
+	}
+
+	if len(ms) != 3 || ms[0].Sid != "SM1" || ms[2].Sid != "SM3" {
+		t.Errorf("Message.SendSplit returned %+v, want SM1 to SM3", ms)
+	}
+}
+
+func TestMessageService_SendSplit_stopsAtError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	var bodies []string
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		bodies = append(bodies, r.PostForm.Get("Body"))
+
+		if len(bodies) == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"status": 503, "code": 20503, "message": "Service Unavailable"}`)
+			return
+		}
+
+		fmt.Fprintf(w, `{"sid": "SM%d"}`, len(bodies))
+	})
+
+	ms, err := client.Messages.SendSplit("+14158141829", "+15558675309", MessageParams{Body: strings.Repeat("lorem ipsum ", 300)})
+
+	if len(bodies) != 2 {
+		t.Errorf("Message.SendSplit sent %d parts, want 2", len(bodies))
+	}
+
+	if len(ms) != 1 || ms[0].Sid != "SM1" {
+		t.Errorf("Message.SendSplit returned %+v, want SM1 only", ms)
+	}
+
+	var e *SplitError
+	if !errors.As(err, &e) || !errors.Is(err, ErrServiceUnavailable) {
+		t.Fatalf("Message.SendSplit returned error %v, want a SplitError", err)
+	}
+
+	if e.Part != 2 || len(e.Unsent) != 2 || e.Unsent[0] != bodies[1] || !strings.HasPrefix(e.Unsent[1], "(3/3) ") {
+		t.Errorf("Message.SendSplit returned error %+v, want parts 2 and 3 unsent", e)
+	}
+
+	if !strings.Contains(err.Error(), "part 2/3") {
+		t.Errorf("Message.SendSplit returned error %q, want part 2/3 to fail", err)
+	}
+}
+
+func TestMessageService_SendSplit_blankBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u := client.EndPoint("Messages")
+
+	mux.HandleFunc(u.String(), func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		if b := r.PostForm.Get("Body"); b != "" {
+			t.Errorf("Request Body = %q, want blank", b)
+		}
+
+		fmt.Fprint(w, `{"sid": "MM1"}`)
+	})
+
+	_, err := client.Messages.SendSplit("+14158141829", "+15558675309", MessageParams{Body: strings.Repeat(" ", 1700)})
+
+	var e *SplitError
+	if err == nil || errors.As(err, &e) {
+		t.Errorf("Message.SendSplit returned error %v, want a validation error", err)
+	}
+
+	params := MessageParams{Body: strings.Repeat(" ", 1700), MediaUrl: []string{"http://www.example.com/hearts.png"}}
+
+	ms, err := client.Messages.SendSplit("+14158141829", "+15558675309", params)
+	if err != nil || len(ms) != 1 || ms[0].Sid != "MM1" {
+		t.Errorf("Message.SendSplit returned %+v, %v, want MM1", ms, err)
+	}
+}
@@ -0,0 +1,161 @@
+package twilio
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// MessageStatus is the delivery status of a message.
+type MessageStatus string
+
+const (
+	MessageStatusAccepted    MessageStatus = "accepted"
+	MessageStatusScheduled   MessageStatus = "scheduled"
+	MessageStatusQueued      MessageStatus = "queued"
+	MessageStatusSending     MessageStatus = "sending"
+	MessageStatusSent        MessageStatus = "sent"
+	MessageStatusDelivered   MessageStatus = "delivered"
+	MessageStatusUndelivered MessageStatus = "undelivered"
+	MessageStatusFailed      MessageStatus = "failed"
+	MessageStatusRead        MessageStatus = "read"
+	MessageStatusCanceled    MessageStatus = "canceled"
+	MessageStatusReceiving   MessageStatus = "receiving"
+	MessageStatusReceived    MessageStatus = "received"
+)
+
+// IsFinal reports whether the status won't change anymore.
+func (s MessageStatus) IsFinal() bool {
+	switch s {
+	case MessageStatusDelivered, MessageStatusUndelivered, MessageStatusFailed,
+		MessageStatusRead, MessageStatusCanceled, MessageStatusReceived:
+		return true
+	}
+
+	return false
+}
+
+// IsFailure reports whether the message couldn't be delivered.
+func (s MessageStatus) IsFailure() bool {
+	return s == MessageStatusUndelivered || s == MessageStatusFailed
+}
+
+// StatusCallback holds the params Twilio POSTs to the StatusCallback URL when the status of a message changes.
+type StatusCallback struct {
+	MessageSid    string
+	AccountSid    string
+	From          string
+	To            string
+	ApiVersion    string
+	MessageStatus MessageStatus
+	SmsStatus     MessageStatus
+
+	// Set when the message failed or couldn't be delivered.
+	ErrorCode ErrorCode
+}
+
+// FromNumber returns the sender as PhoneNumber.
+func (c *StatusCallback) FromNumber() PhoneNumber {
+	return PhoneNumber(c.From)
+}
+
+// ToNumber returns the recipient as PhoneNumber.
+func (c *StatusCallback) ToNumber() PhoneNumber {
+	return PhoneNumber(c.To)
+}
+
+// ParseStatusCallback parses a message status callback request.
+// Use WebhookValidator to ensure the request was actually sent by Twilio.
+func ParseStatusCallback(r *http.Request) (*StatusCallback, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	cb := &StatusCallback{
+		MessageSid:    f.Get("MessageSid"),
+		AccountSid:    f.Get("AccountSid"),
+		From:          f.Get("From"),
+		To:            f.Get("To"),
+		ApiVersion:    f.Get("ApiVersion"),
+		MessageStatus: MessageStatus(f.Get("MessageStatus")),
+		SmsStatus:     MessageStatus(f.Get("SmsStatus")),
+	}
+
+	if cb.MessageSid == "" {
+		cb.MessageSid = f.Get("SmsSid")
+	}
+
+	if cb.MessageStatus == "" {
+		cb.MessageStatus = cb.SmsStatus
+	}
+
+	if cb.MessageSid == "" || cb.MessageStatus == "" {
+		return nil, errors.New(`Status callback requires "MessageSid" and "MessageStatus".`)
+	}
+
+	code, err := formInt(f.Get("ErrorCode"))
+	if err != nil {
+		return nil, err
+	}
+	cb.ErrorCode = ErrorCode(code)
+
+	return cb, nil
+}
+
+// StatusCallbackHandler is an http.Handler decoding message status callbacks and dispatching them
+// to the callback registered for their status:
+//
+//	h := twilio.NewStatusCallbackHandler()
+//	h.On(twilio.MessageStatusDelivered, func(cb *twilio.StatusCallback) {
+//		// mark cb.MessageSid as delivered
+//	})
+//	http.Handle("/status", c.WebhookValidator().Handler(h))
+type StatusCallbackHandler struct {
+	// Called for statuses without a registered callback. Optional.
+	Default func(cb *StatusCallback)
+
+	mu        sync.RWMutex
+	callbacks map[MessageStatus]func(cb *StatusCallback)
+}
+
+// NewStatusCallbackHandler returns a StatusCallbackHandler without callbacks. The zero value is ready to use as well.
+func NewStatusCallbackHandler() *StatusCallbackHandler {
+	return &StatusCallbackHandler{callbacks: map[MessageStatus]func(cb *StatusCallback){}}
+}
+
+// On registers fn to be called for callbacks reporting status, replacing any previous one.
+func (h *StatusCallbackHandler) On(status MessageStatus, fn func(cb *StatusCallback)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.callbacks == nil {
+		h.callbacks = map[MessageStatus]func(cb *StatusCallback){}
+	}
+
+	h.callbacks[status] = fn
+}
+
+// ServeHTTP implements http.Handler. Malformed callbacks are answered with 400 Bad Request.
+func (h *StatusCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cb, err := ParseStatusCallback(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fn, ok := h.callbacks[cb.MessageStatus]
+	h.mu.RUnlock()
+
+	if !ok {
+		fn = h.Default
+	}
+
+	if fn != nil {
+		fn(cb)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,121 @@
+package twilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestMessageStatus_IsFinal(t *testing.T) {
+	for _, s := range []MessageStatus{MessageStatusDelivered, MessageStatusUndelivered, MessageStatusFailed, MessageStatusRead, MessageStatusCanceled, MessageStatusReceived} {
+		if !s.IsFinal() {
+			t.Errorf("MessageStatus(%q).IsFinal() should be true", s)
+		}
+	}
+
+	for _, s := range []MessageStatus{MessageStatusAccepted, MessageStatusScheduled, MessageStatusQueued, MessageStatusSending, MessageStatusSent, MessageStatusReceiving} {
+		if s.IsFinal() {
+			t.Errorf("MessageStatus(%q).IsFinal() should be false", s)
+		}
+	}
+}
+
+func TestMessageStatus_IsFailure(t *testing.T) {
+	if !MessageStatusFailed.IsFailure() || !MessageStatusUndelivered.IsFailure() {
+		t.Error("MessageStatus.IsFailure() should be true")
+	}
+
+	if MessageStatusDelivered.IsFailure() {
+		t.Error("MessageStatus.IsFailure() should be false")
+	}
+}
+
+func TestParseStatusCallback(t *testing.T) {
+	r := newFormRequest(url.Values{
+		"MessageSid":    {"SM1"},
+		"AccountSid":    {"AC5ef87"},
+		"From":          {"+14158141829"},
+		"To":            {"+15558675309"},
+		"MessageStatus": {"undelivered"},
+		"SmsStatus":     {"undelivered"},
+		"ErrorCode":     {"30003"},
+	})
+
+	cb, err := ParseStatusCallback(r)
+	if err != nil {
+		t.Errorf("ParseStatusCallback returned error: %v", err)
+	}
+
+	want := &StatusCallback{
+		MessageSid:    "SM1",
+		AccountSid:    "AC5ef87",
+		From:          "+14158141829",
+		To:            "+15558675309",
+		MessageStatus: MessageStatusUndelivered,
+		SmsStatus:     MessageStatusUndelivered,
+		ErrorCode:     ErrUnreachable,
+	}
+
+	if !reflect.DeepEqual(cb, want) {
+		t.Errorf("ParseStatusCallback returned %+v, want %+v", cb, want)
+	}
+}
+
+func TestStatusCallbackHandler(t *testing.T) {
+	h := NewStatusCallbackHandler()
+
+	var delivered, other []string
+	h.On(MessageStatusDelivered, func(cb *StatusCallback) {
+		delivered = append(delivered, cb.MessageSid)
+	})
+	h.Default = func(cb *StatusCallback) {
+		other = append(other, cb.MessageSid)
+	}
+
+	for _, v := range []url.Values{
+		{"MessageSid": {"SM1"}, "MessageStatus": {"sent"}},
+		{"MessageSid": {"SM1"}, "MessageStatus": {"delivered"}},
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest(v))
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("StatusCallbackHandler status code = %d, want %d", w.Code, http.StatusNoContent)
+		}
+	}
+
+	if !reflect.DeepEqual(delivered, []string{"SM1"}) || !reflect.DeepEqual(other, []string{"SM1"}) {
+		t.Errorf("StatusCallbackHandler dispatched delivered=%v other=%v", delivered, other)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFormRequest(url.Values{}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("StatusCallbackHandler status code = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestStatusCallbackHandler_zeroValue(t *testing.T) {
+	h := &StatusCallbackHandler{}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFormRequest(url.Values{"MessageSid": {"SM1"}, "MessageStatus": {"sent"}}))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("StatusCallbackHandler status code = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	var sent []string
+	h.On(MessageStatusSent, func(cb *StatusCallback) {
+		sent = append(sent, cb.MessageSid)
+	})
+
+	h.ServeHTTP(httptest.NewRecorder(), newFormRequest(url.Values{"MessageSid": {"SM1"}, "MessageStatus": {"sent"}}))
+
+	if !reflect.DeepEqual(sent, []string{"SM1"}) {
+		t.Errorf("StatusCallbackHandler dispatched sent=%v", sent)
+	}
+}
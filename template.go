@@ -0,0 +1,110 @@
+package twilio
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var placeholderRe = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// MessageTemplate renders personalized messages from a body with placeholders, such as:
+//
+//	t := twilio.MessageTemplate{Body: "Hi {{name}}, your order {{order}} has shipped."}
+//	params, err := t.Render(map[string]string{"name": "Jane", "order": "#1234"})
+type MessageTemplate struct {
+	// Body with "{{name}}" placeholders.
+	Body string
+
+	// Params of the rendered messages, their Body is replaced by the rendered one.
+	Params MessageParams
+
+	// Whether rendered bodies must only use GSM-7 characters, after smart encoding when enabled in Params,
+	// so they don't fall back to the costlier UCS-2.
+	RequireGSM7 bool
+}
+
+// MissingVarsError is returned when rendering a template without a value for some of its placeholders.
+type MissingVarsError struct {
+	Names []string
+}
+
+func (e *MissingVarsError) Error() string {
+	return "twilio: missing template variables: " + strings.Join(e.Names, ", ")
+}
+
+// NonGSM7Error is returned when rendering a template requiring GSM-7 gives a body with characters forcing UCS-2.
+type NonGSM7Error struct {
+	Chars []rune
+}
+
+func (e *NonGSM7Error) Error() string {
+	return fmt.Sprintf("twilio: rendered body has characters outside of GSM-7: %q", string(e.Chars))
+}
+
+// Placeholders returns the names of the placeholders of the template, in order of appearance.
+func (t MessageTemplate) Placeholders() []string {
+	var names []string
+
+	seen := map[string]bool{}
+	for _, m := range placeholderRe.FindAllStringSubmatch(t.Body, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+
+	return names
+}
+
+// Render returns the params of the message for vars. It fails with a MissingVarsError when a placeholder has
+// no value in vars, and with a NonGSM7Error when RequireGSM7 is set and the rendered body has characters forcing UCS-2.
+func (t MessageTemplate) Render(vars map[string]string) (MessageParams, error) {
+	var missing []string
+	for _, name := range t.Placeholders() {
+		if _, ok := vars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return MessageParams{}, &MissingVarsError{Names: missing}
+	}
+
+	params := t.Params
+	params.Body = placeholderRe.ReplaceAllStringFunc(t.Body, func(s string) string {
+		return vars[placeholderRe.FindStringSubmatch(s)[1]]
+	})
+
+	if t.RequireGSM7 {
+		if info := t.segments(params); info.Encoding != EncodingGSM7 {
+			return MessageParams{}, &NonGSM7Error{Chars: info.UnicodeChars}
+		}
+	}
+
+	return params, nil
+}
+
+// Preview renders the message for vars without sending it, and returns how it would be split into segments.
+func (t MessageTemplate) Preview(vars map[string]string) (SegmentInfo, error) {
+	params, err := t.Render(vars)
+	if err != nil {
+		return SegmentInfo{}, err
+	}
+
+	return t.segments(params), nil
+}
+
+// Recipient renders the message for vars as a batch recipient. See MessageService.SendBatch.
+func (t MessageTemplate) Recipient(to string, vars map[string]string) (Recipient, error) {
+	params, err := t.Render(vars)
+	if err != nil {
+		return Recipient{}, err
+	}
+
+	return Recipient{To: to, Params: &params}, nil
+}
+
+func (t MessageTemplate) segments(params MessageParams) SegmentInfo {
+	return CalculateSegments(params.Body, params.SmartEncoded != nil && *params.SmartEncoded)
+}
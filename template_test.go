@@ -0,0 +1,94 @@
+package twilio
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMessageTemplate_Placeholders(t *testing.T) {
+	tpl := MessageTemplate{Body: "Hi {{name}}, your order {{ order }} is ready. Thanks {{name}}!"}
+
+	want := []string{"name", "order"}
+	if got := tpl.Placeholders(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MessageTemplate.Placeholders returned %q, want %q", got, want)
+	}
+}
+
+func TestMessageTemplate_Render(t *testing.T) {
+	tpl := MessageTemplate{
+		Body:   "Hi {{name}}, your order {{ order }} is ready.",
+		Params: MessageParams{StatusCallback: "http://example.com/status"},
+	}
+
+	p, err := tpl.Render(map[string]string{"name": "Jane", "order": "#1234"})
+	if err != nil {
+		t.Fatalf("MessageTemplate.Render returned error: %v", err)
+	}
+
+	want := MessageParams{Body: "Hi Jane, your order #1234 is ready.", StatusCallback: "http://example.com/status"}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("MessageTemplate.Render returned %+v, want %+v", p, want)
+	}
+}
+
+func TestMessageTemplate_Render_missingVars(t *testing.T) {
+	tpl := MessageTemplate{Body: "Hi {{name}}, your order {{order}} ships {{date}}."}
+
+	_, err := tpl.Render(map[string]string{"order": "#1234"})
+
+	var e *MissingVarsError
+	if !errors.As(err, &e) {
+		t.Fatalf("MessageTemplate.Render returned error %v, want a MissingVarsError", err)
+	}
+
+	if want := []string{"name", "date"}; !reflect.DeepEqual(e.Names, want) {
+		t.Errorf("MessageTemplate.Render missing %q, want %q", e.Names, want)
+	}
+}
+
+func TestMessageTemplate_Render_requireGSM7(t *testing.T) {
+	tpl := MessageTemplate{Body: "Hi {{name}}", RequireGSM7: true}
+
+	_, err := tpl.Render(map[string]string{"name": "Zoë"})
+
+	var e *NonGSM7Error
+	if !errors.As(err, &e) || string(e.Chars) != "ë" {
+		t.Errorf("MessageTemplate.Render returned error %v, want a NonGSM7Error for %q", err, "ë")
+	}
+
+	if _, err := tpl.Render(map[string]string{"name": "Zoé"}); err != nil {
+		t.Errorf("MessageTemplate.Render returned error: %v", err)
+	}
+
+	tpl.Params.SmartEncoded = Bool(true)
+	if _, err := tpl.Render(map[string]string{"name": "“Jo”"}); err != nil {
+		t.Errorf("MessageTemplate.Render with smart encoding returned error: %v", err)
+	}
+}
+
+func TestMessageTemplate_Preview(t *testing.T) {
+	tpl := MessageTemplate{Body: "Hi {{name}}"}
+
+	info, err := tpl.Preview(map[string]string{"name": "Jane"})
+	if err != nil {
+		t.Fatalf("MessageTemplate.Preview returned error: %v", err)
+	}
+
+	if info.Body != "Hi Jane" || info.NumSegments() != 1 || info.Encoding != EncodingGSM7 {
+		t.Errorf("MessageTemplate.Preview returned %+v", info)
+	}
+}
+
+func TestMessageTemplate_Recipient(t *testing.T) {
+	tpl := MessageTemplate{Body: "Hi {{name}}"}
+
+	r, err := tpl.Recipient("+15558675309", map[string]string{"name": "Jane"})
+	if err != nil {
+		t.Fatalf("MessageTemplate.Recipient returned error: %v", err)
+	}
+
+	if r.To != "+15558675309" || r.Params.Body != "Hi Jane" {
+		t.Errorf("MessageTemplate.Recipient returned %+v", r)
+	}
+}
@@ -0,0 +1,102 @@
+package twilio
+
+import (
+	"context"
+)
+
+// TranscriptionService manages transcriptions, either of the whole account (Client.Transcriptions)
+// or of a single recording (see RecordingService.Transcriptions).
+type TranscriptionService struct {
+	client *Client
+
+	// Endpoint path parts leading to the transcriptions list, eg: "Recordings", "RE123", "Transcriptions".
+	parts []string
+}
+
+type Transcription struct {
+	Sid               string    `json:"sid"`
+	AccountSid        string    `json:"account_sid"`
+	RecordingSid      string    `json:"recording_sid"`
+	ApiVersion        string    `json:"api_version"`
+	DateCreated       Timestamp `json:"date_created,omitempty"`
+	DateUpdated       Timestamp `json:"date_updated,omitempty"`
+	Duration          int       `json:"duration,string"`
+	Status            string    `json:"status"`
+	TranscriptionText string    `json:"transcription_text"`
+	Type              string    `json:"type"`
+	Price             Price     `json:"price,omitempty"`
+	PriceUnit         string    `json:"price_unit"`
+	Uri               string    `json:"uri"`
+}
+
+// Transcriptions returns the service managing transcriptions of the recording sid.
+func (s *RecordingService) Transcriptions(sid string) *TranscriptionService {
+	return &TranscriptionService{client: s.client, parts: []string{"Recordings", sid, "Transcriptions"}}
+}
+
+func (s *TranscriptionService) endPoint(parts ...string) []string {
+	return append(append([]string{}, s.parts...), parts...)
+}
+
+func (s *TranscriptionService) Get(sid string) (*Transcription, *Response, error) {
+	return s.GetContext(context.Background(), sid)
+}
+
+// GetContext is like Get but bound to ctx.
+func (s *TranscriptionService) GetContext(ctx context.Context, sid string) (*Transcription, *Response, error) {
+	u := s.client.EndPoint(s.endPoint(sid)...)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	t := new(Transcription)
+	resp, err := s.client.DoContext(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+type TranscriptionListParams struct {
+	PageSize int
+}
+
+func (s *TranscriptionService) List(params TranscriptionListParams) ([]Transcription, *Response, error) {
+	return s.ListContext(context.Background(), params)
+}
+
+// ListContext is like List but bound to ctx.
+func (s *TranscriptionService) ListContext(ctx context.Context, params TranscriptionListParams) ([]Transcription, *Response, error) {
+	u := s.client.EndPoint(s.endPoint()...)
+	setQuery(u, structToUrlValues(&params))
+
+	req, _ := s.client.NewRequestWithContext(ctx, "GET", u.String(), nil)
+
+	type list struct {
+		Pagination
+		Transcriptions []Transcription `json:"transcriptions"`
+	}
+
+	l := new(list)
+	resp, err := s.client.DoContext(ctx, req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.Pagination = l.Pagination
+
+	return l.Transcriptions, resp, err
+}
+
+func (s *TranscriptionService) Delete(sid string) (*Response, error) {
+	return s.DeleteContext(context.Background(), sid)
+}
+
+// DeleteContext is like Delete but bound to ctx.
+func (s *TranscriptionService) DeleteContext(ctx context.Context, sid string) (*Response, error) {
+	u := s.client.EndPoint(s.endPoint(sid)...)
+
+	req, _ := s.client.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+
+	return s.client.DoContext(ctx, req, nil)
+}
@@ -0,0 +1,123 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+)
+
+// MessagingResponse builds the TwiML returned to Twilio when a message is received:
+//
+//	r := twiml.NewMessagingResponse()
+//	r.Message("Thanks for your message!").AddMedia("https://example.com/logo.png")
+//	r.Write(w)
+type MessagingResponse struct {
+	document
+}
+
+// NewMessagingResponse returns an empty MessagingResponse.
+func NewMessagingResponse() *MessagingResponse {
+	return &MessagingResponse{}
+}
+
+// Message appends a <Message> replying with body, and returns it so that attributes and media can be set.
+func (r *MessagingResponse) Message(body string) *Message {
+	m := &Message{Body: body}
+	r.Verbs = append(r.Verbs, m)
+	return m
+}
+
+// Redirect appends a <Redirect> transferring control to the TwiML at url.
+func (r *MessagingResponse) Redirect(url string) *Redirect {
+	rd := &Redirect{Url: url}
+	r.Verbs = append(r.Verbs, rd)
+	return rd
+}
+
+// Validate checks every verb of the response.
+func (r *MessagingResponse) Validate() error {
+	return r.validate()
+}
+
+// Marshal validates the response and returns it as XML document.
+func (r *MessagingResponse) Marshal() ([]byte, error) {
+	return r.marshal()
+}
+
+// Write validates the response and writes it to w along with the XML content type.
+func (r *MessagingResponse) Write(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+// Maximum number of <Media> in a <Message>.
+const maxMedia = 10
+
+// Message is the <Message> verb, sending a message in reply.
+type Message struct {
+	XMLName xml.Name `xml:"Message"`
+
+	// Recipient, defaults to the sender of the inbound message.
+	To string `xml:"to,attr,omitempty"`
+
+	// Sender, defaults to the number which received the inbound message.
+	From string `xml:"from,attr,omitempty"`
+
+	// URL Twilio requests when the message status changes, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	// The text of the message, limited to 1600 characters.
+	Body string `xml:"Body,omitempty"`
+
+	// URLs of the media sent with the message, up to 10.
+	Media []string `xml:"Media"`
+}
+
+// AddMedia appends media URLs to the message.
+func (m *Message) AddMedia(urls ...string) *Message {
+	m.Media = append(m.Media, urls...)
+	return m
+}
+
+func (m *Message) validate() error {
+	if m.Body == "" && len(m.Media) == 0 {
+		return errors.New("twiml: <Message> requires Body or Media")
+	}
+
+	if n := utf8.RuneCountInString(m.Body); n > 1600 {
+		return fmt.Errorf("twiml: <Message> body is limited to 1600 characters, got %d", n)
+	}
+
+	if len(m.Media) > maxMedia {
+		return fmt.Errorf("twiml: <Message> is limited to %d media, got %d", maxMedia, len(m.Media))
+	}
+
+	for _, u := range m.Media {
+		if err := validateURL("Media", "URL", u, true); err != nil {
+			return err
+		}
+	}
+
+	if err := validateURL("Message", "action", m.Action, false); err != nil {
+		return err
+	}
+
+	return validateMethod("Message", m.Method)
+}
+
+// Redirect is the <Redirect> verb, transferring control to the TwiML at another URL.
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	Method  string   `xml:"method,attr,omitempty"`
+	Url     string   `xml:",chardata"`
+}
+
+func (r *Redirect) validate() error {
+	if err := validateURL("Redirect", "URL", r.Url, true); err != nil {
+		return err
+	}
+
+	return validateMethod("Redirect", r.Method)
+}
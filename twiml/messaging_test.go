@@ -0,0 +1,84 @@
+package twiml
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMessagingResponse_Marshal(t *testing.T) {
+	r := NewMessagingResponse()
+
+	m := r.Message("Tom & Jerry <3")
+	m.To = "+15558675309"
+	m.Action = "https://example.com/status?a=1&b=2"
+	m.Method = "POST"
+	m.AddMedia("https://example.com/cat.png")
+
+	r.Redirect("https://example.com/next").Method = "GET"
+
+	b, err := r.Marshal()
+	if err != nil {
+		t.Fatalf("MessagingResponse.Marshal returned error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<Response>` +
+		`<Message to="+15558675309" action="https://example.com/status?a=1&amp;b=2" method="POST">` +
+		`<Body>Tom &amp; Jerry &lt;3</Body><Media>https://example.com/cat.png</Media>` +
+		`</Message>` +
+		`<Redirect method="GET">https://example.com/next</Redirect>` +
+		`</Response>`
+
+	if string(b) != want {
+		t.Errorf("MessagingResponse.Marshal returned %s, want %s", b, want)
+	}
+}
+
+func TestMessagingResponse_empty(t *testing.T) {
+	b, err := NewMessagingResponse().Marshal()
+	if err != nil {
+		t.Fatalf("MessagingResponse.Marshal returned error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<Response></Response>`
+	if string(b) != want {
+		t.Errorf("MessagingResponse.Marshal returned %s, want %s", b, want)
+	}
+}
+
+func TestMessagingResponse_Validate(t *testing.T) {
+	tests := []func(r *MessagingResponse){
+		func(r *MessagingResponse) { r.Message("") },
+		func(r *MessagingResponse) { r.Message("Hi").Method = "PUT" },
+		func(r *MessagingResponse) { r.Message("Hi").AddMedia(make([]string, 11)...) },
+		func(r *MessagingResponse) { r.Redirect("") },
+	}
+
+	for i, fn := range tests {
+		r := NewMessagingResponse()
+		fn(r)
+
+		if err := r.Validate(); err == nil {
+			t.Errorf("#%d MessagingResponse.Validate expected an error to be returned", i)
+		}
+	}
+}
+
+func TestMessagingResponse_Write(t *testing.T) {
+	r := NewMessagingResponse()
+	r.Message("Hello")
+
+	w := httptest.NewRecorder()
+	if err := r.Write(w); err != nil {
+		t.Fatalf("MessagingResponse.Write returned error: %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/xml" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/xml")
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<Response><Message><Body>Hello</Body></Message></Response>`
+	if w.Body.String() != want {
+		t.Errorf("MessagingResponse.Write wrote %s, want %s", w.Body.String(), want)
+	}
+}
@@ -0,0 +1,98 @@
+// Package twiml provides builders for TwiML, the XML documents Twilio expects in response to webhooks.
+// MessagingResponse replies to incoming messages and VoiceResponse controls calls.
+package twiml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// element is implemented by every TwiML verb and noun, so documents can be validated before being marshalled.
+type element interface {
+	validate() error
+}
+
+// document is the <Response> root shared by messaging and voice responses.
+type document struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []element
+}
+
+func (d *document) validate() error {
+	return validateAll(d.Verbs)
+}
+
+func (d *document) marshal() ([]byte, error) {
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := xml.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+func (d *document) write(w http.ResponseWriter) error {
+	b, err := d.marshal()
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	_, err = w.Write(b)
+	return err
+}
+
+func validateAll(elements []element) error {
+	for _, e := range elements {
+		if err := e.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMethod(verb, method string) error {
+	switch method {
+	case "", "GET", "POST":
+		return nil
+	}
+
+	return fmt.Errorf("twiml: <%s> method must be GET or POST, got %q", verb, method)
+}
+
+func validateURL(verb, attr, u string, required bool) error {
+	if u == "" {
+		if required {
+			return fmt.Errorf("twiml: <%s> requires %s", verb, attr)
+		}
+		return nil
+	}
+
+	if _, err := url.Parse(u); err != nil {
+		return fmt.Errorf("twiml: <%s> %s is not a valid URL: %v", verb, attr, err)
+	}
+
+	return nil
+}
+
+func validateOneOf(verb, attr, value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("twiml: <%s> %s must be one of %s, got %q", verb, attr, strings.Join(allowed, ", "), value)
+}
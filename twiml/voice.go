@@ -0,0 +1,828 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// VoiceResponse builds the TwiML returned to Twilio to control a call:
+//
+//	r := twiml.NewVoiceResponse()
+//	g := r.Gather()
+//	g.Action = "/menu"
+//	g.NumDigits = 1
+//	g.Say("Press 1 for sales, 2 for support.")
+//	r.Say("We didn't receive any input. Goodbye!")
+//	r.Write(w)
+type VoiceResponse struct {
+	document
+}
+
+// NewVoiceResponse returns an empty VoiceResponse.
+func NewVoiceResponse() *VoiceResponse {
+	return &VoiceResponse{}
+}
+
+// voiceVerb is implemented by verbs allowed directly under a voice <Response>.
+type voiceVerb interface {
+	element
+	voiceVerb()
+}
+
+func (r *VoiceResponse) append(v voiceVerb) {
+	r.Verbs = append(r.Verbs, v)
+}
+
+// Say appends a <Say> reading text to the caller.
+func (r *VoiceResponse) Say(text string) *Say {
+	s := &Say{Text: text}
+	r.append(s)
+	return s
+}
+
+// Play appends a <Play> playing the audio file at url.
+func (r *VoiceResponse) Play(url string) *Play {
+	p := &Play{Url: url}
+	r.append(p)
+	return p
+}
+
+// Pause appends a <Pause> waiting silently for length seconds.
+func (r *VoiceResponse) Pause(length int) *Pause {
+	p := &Pause{Length: length}
+	r.append(p)
+	return p
+}
+
+// Gather appends a <Gather> collecting digits or speech from the caller.
+func (r *VoiceResponse) Gather() *Gather {
+	g := &Gather{}
+	r.append(g)
+	return g
+}
+
+// Dial appends a <Dial> connecting the caller to number. Leave number blank to dial nouns added to the returned Dial.
+func (r *VoiceResponse) Dial(number string) *Dial {
+	d := &Dial{Number: number}
+	r.append(d)
+	return d
+}
+
+// Record appends a <Record> recording the caller's voice.
+func (r *VoiceResponse) Record() *Record {
+	rc := &Record{}
+	r.append(rc)
+	return rc
+}
+
+// Hangup appends a <Hangup> ending the call.
+func (r *VoiceResponse) Hangup() *Hangup {
+	h := &Hangup{}
+	r.append(h)
+	return h
+}
+
+// Reject appends a <Reject> declining an incoming call without being billed. Reason is either "rejected" or "busy".
+func (r *VoiceResponse) Reject(reason string) *Reject {
+	rj := &Reject{Reason: reason}
+	r.append(rj)
+	return rj
+}
+
+// Enqueue appends an <Enqueue> placing the caller in the queue named name.
+func (r *VoiceResponse) Enqueue(name string) *Enqueue {
+	e := &Enqueue{Name: name}
+	r.append(e)
+	return e
+}
+
+// Redirect appends a <Redirect> transferring control to the TwiML at url.
+func (r *VoiceResponse) Redirect(url string) *Redirect {
+	rd := &Redirect{Url: url}
+	r.append(rd)
+	return rd
+}
+
+// Connect appends a <Connect>, used to stream the call audio.
+func (r *VoiceResponse) Connect() *Connect {
+	c := &Connect{}
+	r.append(c)
+	return c
+}
+
+// Validate checks every verb of the response, including its nesting.
+func (r *VoiceResponse) Validate() error {
+	for _, v := range r.Verbs {
+		if _, ok := v.(voiceVerb); !ok {
+			return fmt.Errorf("twiml: %T is not allowed in a voice <Response>", v)
+		}
+	}
+
+	return r.validate()
+}
+
+// Marshal validates the response and returns it as XML document.
+func (r *VoiceResponse) Marshal() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return r.marshal()
+}
+
+// Write validates the response and writes it to w along with the XML content type.
+func (r *VoiceResponse) Write(w http.ResponseWriter) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	return r.write(w)
+}
+
+// Int returns a pointer to n, for optional attributes where zero is meaningful.
+func Int(n int) *int {
+	return &n
+}
+
+// Bool returns a pointer to b, for optional attributes where false is meaningful.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Say is the <Say> verb, reading text to the caller with text-to-speech.
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+
+	// Number of times the text is read. Zero repeats it until the call ends.
+	Loop *int `xml:"loop,attr,omitempty"`
+
+	Text string `xml:",chardata"`
+}
+
+func (*Say) voiceVerb()  {}
+func (*Say) gatherNoun() {}
+
+func (s *Say) validate() error {
+	if strings.TrimSpace(s.Text) == "" {
+		return errors.New("twiml: <Say> requires text")
+	}
+
+	return validateLoop("Say", s.Loop)
+}
+
+// Play is the <Play> verb, playing an audio file or DTMF digits.
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+
+	// Number of times the audio is played. Zero repeats it until the call ends.
+	Loop *int `xml:"loop,attr,omitempty"`
+
+	// DTMF tones to play instead of an audio file. "w" waits half a second.
+	Digits string `xml:"digits,attr,omitempty"`
+
+	Url string `xml:",chardata"`
+}
+
+func (*Play) voiceVerb()  {}
+func (*Play) gatherNoun() {}
+
+func (p *Play) validate() error {
+	if p.Url == "" && p.Digits == "" {
+		return errors.New("twiml: <Play> requires URL or digits")
+	}
+
+	if err := validateDigits("Play", "digits", p.Digits); err != nil {
+		return err
+	}
+
+	if err := validateURL("Play", "URL", p.Url, false); err != nil {
+		return err
+	}
+
+	return validateLoop("Play", p.Loop)
+}
+
+// Pause is the <Pause> verb, waiting silently.
+type Pause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr,omitempty"`
+}
+
+func (*Pause) voiceVerb()  {}
+func (*Pause) gatherNoun() {}
+
+func (p *Pause) validate() error {
+	if p.Length < 0 {
+		return fmt.Errorf("twiml: <Pause> length must not be negative, got %d", p.Length)
+	}
+
+	return nil
+}
+
+// gatherNoun is implemented by verbs allowed inside <Gather>: <Say>, <Play> and <Pause>.
+type gatherNoun interface {
+	element
+	gatherNoun()
+}
+
+// Gather is the <Gather> verb, collecting digits or speech from the caller.
+type Gather struct {
+	XMLName xml.Name `xml:"Gather"`
+
+	// Either "dtmf", "speech" or "dtmf speech".
+	Input string `xml:"input,attr,omitempty"`
+
+	// URL Twilio requests with the gathered input, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	// Seconds to wait for the caller's input.
+	Timeout int `xml:"timeout,attr,omitempty"`
+
+	// Key submitting the digits, one of 0-9, # or *.
+	FinishOnKey string `xml:"finishOnKey,attr,omitempty"`
+
+	NumDigits     int    `xml:"numDigits,attr,omitempty"`
+	Language      string `xml:"language,attr,omitempty"`
+	Hints         string `xml:"hints,attr,omitempty"`
+	SpeechTimeout string `xml:"speechTimeout,attr,omitempty"`
+
+	Nouns []gatherNoun
+}
+
+func (*Gather) voiceVerb() {}
+
+// Say nests a <Say> in the gather, so input can be given while it's read.
+func (g *Gather) Say(text string) *Say {
+	s := &Say{Text: text}
+	g.Nouns = append(g.Nouns, s)
+	return s
+}
+
+// Play nests a <Play> in the gather.
+func (g *Gather) Play(url string) *Play {
+	p := &Play{Url: url}
+	g.Nouns = append(g.Nouns, p)
+	return p
+}
+
+// Pause nests a <Pause> in the gather.
+func (g *Gather) Pause(length int) *Pause {
+	p := &Pause{Length: length}
+	g.Nouns = append(g.Nouns, p)
+	return p
+}
+
+func (g *Gather) validate() error {
+	if err := validateOneOf("Gather", "input", g.Input, "dtmf", "speech", "dtmf speech", "speech dtmf"); err != nil {
+		return err
+	}
+
+	if err := validateURL("Gather", "action", g.Action, false); err != nil {
+		return err
+	}
+
+	if err := validateMethod("Gather", g.Method); err != nil {
+		return err
+	}
+
+	if g.Timeout < 0 || g.NumDigits < 0 {
+		return errors.New("twiml: <Gather> timeout and numDigits must not be negative")
+	}
+
+	if len(g.FinishOnKey) > 1 {
+		return fmt.Errorf("twiml: <Gather> finishOnKey must be a single key, got %q", g.FinishOnKey)
+	}
+
+	if err := validateKeys("Gather", "finishOnKey", g.FinishOnKey); err != nil {
+		return err
+	}
+
+	for _, n := range g.Nouns {
+		if err := n.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dialNoun is implemented by nouns allowed inside <Dial>: <Number>, <Client>, <Sip>, <Conference> and <Queue>.
+type dialNoun interface {
+	element
+	dialNoun()
+}
+
+// Dial is the <Dial> verb, connecting the caller to another party.
+type Dial struct {
+	XMLName xml.Name `xml:"Dial"`
+
+	// URL Twilio requests when the dialed call ends, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	// Seconds to wait for the dialed party to answer.
+	Timeout int `xml:"timeout,attr,omitempty"`
+
+	// Maximum duration of the call in seconds.
+	TimeLimit int `xml:"timeLimit,attr,omitempty"`
+
+	CallerId                string `xml:"callerId,attr,omitempty"`
+	HangupOnStar            bool   `xml:"hangupOnStar,attr,omitempty"`
+	Record                  string `xml:"record,attr,omitempty"`
+	RecordingStatusCallback string `xml:"recordingStatusCallback,attr,omitempty"`
+
+	// Phone number to dial. It can't be combined with nouns.
+	Number string `xml:",chardata"`
+
+	Nouns []dialNoun
+}
+
+func (*Dial) voiceVerb() {}
+
+func (d *Dial) appendNoun(n dialNoun) {
+	d.Nouns = append(d.Nouns, n)
+}
+
+// AddNumber nests a <Number>. Up to 10 numbers, clients and SIP endpoints can be dialed at once; the first to answer is connected.
+func (d *Dial) AddNumber(number string) *Number {
+	n := &Number{Number: number}
+	d.appendNoun(n)
+	return n
+}
+
+// AddClient nests a <Client> dialing a Twilio Client identity.
+func (d *Dial) AddClient(identity string) *Client {
+	c := &Client{Identity: identity}
+	d.appendNoun(c)
+	return c
+}
+
+// AddSip nests a <Sip> dialing a SIP URI.
+func (d *Dial) AddSip(uri string) *Sip {
+	s := &Sip{Uri: uri}
+	d.appendNoun(s)
+	return s
+}
+
+// AddConference nests a <Conference> connecting the caller to the conference room named name.
+func (d *Dial) AddConference(name string) *Conference {
+	c := &Conference{Name: name}
+	d.appendNoun(c)
+	return c
+}
+
+// AddQueue nests a <Queue> connecting the caller to the front member of the queue named name.
+func (d *Dial) AddQueue(name string) *Queue {
+	q := &Queue{Name: name}
+	d.appendNoun(q)
+	return q
+}
+
+// Maximum number of parties dialed at once.
+const maxDialNouns = 10
+
+func (d *Dial) validate() error {
+	if d.Number == "" && len(d.Nouns) == 0 {
+		return errors.New("twiml: <Dial> requires a number or nouns")
+	}
+
+	if d.Number != "" && len(d.Nouns) > 0 {
+		return errors.New("twiml: <Dial> can't have both a number and nouns")
+	}
+
+	if len(d.Nouns) > maxDialNouns {
+		return fmt.Errorf("twiml: <Dial> is limited to %d nouns, got %d", maxDialNouns, len(d.Nouns))
+	}
+
+	for _, n := range d.Nouns {
+		switch n.(type) {
+		case *Conference, *Queue:
+			if len(d.Nouns) > 1 {
+				return fmt.Errorf("twiml: %T must be the only noun of <Dial>", n)
+			}
+		}
+
+		if err := n.validate(); err != nil {
+			return err
+		}
+	}
+
+	if d.Timeout < 0 || d.TimeLimit < 0 {
+		return errors.New("twiml: <Dial> timeout and timeLimit must not be negative")
+	}
+
+	if err := validateOneOf("Dial", "record", d.Record, "do-not-record", "record-from-answer", "record-from-ringing", "record-from-answer-dual", "record-from-ringing-dual"); err != nil {
+		return err
+	}
+
+	if err := validateURL("Dial", "action", d.Action, false); err != nil {
+		return err
+	}
+
+	return validateMethod("Dial", d.Method)
+}
+
+// Number is the <Number> noun of <Dial>.
+type Number struct {
+	XMLName xml.Name `xml:"Number"`
+
+	// DTMF tones played once the call is answered.
+	SendDigits string `xml:"sendDigits,attr,omitempty"`
+
+	// URL of TwiML played to the called party before connecting, using Method (GET or POST).
+	Url    string `xml:"url,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	StatusCallback      string `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent string `xml:"statusCallbackEvent,attr,omitempty"`
+
+	Number string `xml:",chardata"`
+}
+
+func (*Number) dialNoun() {}
+
+func (n *Number) validate() error {
+	if n.Number == "" {
+		return errors.New("twiml: <Number> requires a phone number")
+	}
+
+	if err := validateDigits("Number", "sendDigits", n.SendDigits); err != nil {
+		return err
+	}
+
+	if err := validateEvents("Number", n.StatusCallbackEvent); err != nil {
+		return err
+	}
+
+	if err := validateURL("Number", "url", n.Url, false); err != nil {
+		return err
+	}
+
+	return validateMethod("Number", n.Method)
+}
+
+// Client is the <Client> noun of <Dial>.
+type Client struct {
+	XMLName xml.Name `xml:"Client"`
+
+	Url    string `xml:"url,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	StatusCallback      string `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent string `xml:"statusCallbackEvent,attr,omitempty"`
+
+	Identity string `xml:",chardata"`
+}
+
+func (*Client) dialNoun() {}
+
+func (c *Client) validate() error {
+	if c.Identity == "" {
+		return errors.New("twiml: <Client> requires an identity")
+	}
+
+	if err := validateEvents("Client", c.StatusCallbackEvent); err != nil {
+		return err
+	}
+
+	return validateMethod("Client", c.Method)
+}
+
+// Sip is the <Sip> noun of <Dial>.
+type Sip struct {
+	XMLName  xml.Name `xml:"Sip"`
+	Username string   `xml:"username,attr,omitempty"`
+	Password string   `xml:"password,attr,omitempty"`
+
+	Url    string `xml:"url,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	StatusCallback      string `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent string `xml:"statusCallbackEvent,attr,omitempty"`
+
+	Uri string `xml:",chardata"`
+}
+
+func (*Sip) dialNoun() {}
+
+func (s *Sip) validate() error {
+	if !strings.HasPrefix(s.Uri, "sip:") {
+		return fmt.Errorf("twiml: <Sip> requires a sip: URI, got %q", s.Uri)
+	}
+
+	if err := validateEvents("Sip", s.StatusCallbackEvent); err != nil {
+		return err
+	}
+
+	return validateMethod("Sip", s.Method)
+}
+
+// Conference is the <Conference> noun of <Dial>. It must be the only noun of its <Dial>.
+type Conference struct {
+	XMLName xml.Name `xml:"Conference"`
+
+	Muted                  bool   `xml:"muted,attr,omitempty"`
+	StartConferenceOnEnter *bool  `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndConferenceOnExit    bool   `xml:"endConferenceOnExit,attr,omitempty"`
+	MaxParticipants        int    `xml:"maxParticipants,attr,omitempty"`
+	Record                 string `xml:"record,attr,omitempty"`
+
+	// Either "true", "false", "onEnter" or "onExit".
+	Beep string `xml:"beep,attr,omitempty"`
+
+	// URL of TwiML played while waiting for the conference to start, using WaitMethod (GET or POST).
+	WaitUrl    string `xml:"waitUrl,attr,omitempty"`
+	WaitMethod string `xml:"waitMethod,attr,omitempty"`
+
+	StatusCallback      string `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent string `xml:"statusCallbackEvent,attr,omitempty"`
+
+	Name string `xml:",chardata"`
+}
+
+func (*Conference) dialNoun() {}
+
+func (c *Conference) validate() error {
+	if c.Name == "" {
+		return errors.New("twiml: <Conference> requires a name")
+	}
+
+	if c.MaxParticipants != 0 && (c.MaxParticipants < 2 || c.MaxParticipants > 250) {
+		return fmt.Errorf("twiml: <Conference> maxParticipants must be between 2 and 250, got %d", c.MaxParticipants)
+	}
+
+	if err := validateOneOf("Conference", "beep", c.Beep, "true", "false", "onEnter", "onExit"); err != nil {
+		return err
+	}
+
+	if err := validateOneOf("Conference", "record", c.Record, "do-not-record", "record-from-start"); err != nil {
+		return err
+	}
+
+	for _, e := range strings.Fields(c.StatusCallbackEvent) {
+		if err := validateOneOf("Conference", "statusCallbackEvent", e, "start", "end", "join", "leave", "mute", "hold", "modify", "speaker", "announcement"); err != nil {
+			return err
+		}
+	}
+
+	return validateMethod("Conference", c.WaitMethod)
+}
+
+// Queue is the <Queue> noun of <Dial>. It must be the only noun of its <Dial>.
+type Queue struct {
+	XMLName xml.Name `xml:"Queue"`
+
+	// URL of TwiML played to the dequeued caller before connecting, using Method (GET or POST).
+	Url    string `xml:"url,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	Name string `xml:",chardata"`
+}
+
+func (*Queue) dialNoun() {}
+
+func (q *Queue) validate() error {
+	if q.Name == "" {
+		return errors.New("twiml: <Queue> requires a name")
+	}
+
+	return validateMethod("Queue", q.Method)
+}
+
+// Record is the <Record> verb, recording the caller's voice.
+type Record struct {
+	XMLName xml.Name `xml:"Record"`
+
+	// URL Twilio requests once the recording is done, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	// Seconds of silence ending the recording. Zero disables it.
+	Timeout *int `xml:"timeout,attr,omitempty"`
+
+	// Keys ending the recording, any of 0-9, # and *.
+	FinishOnKey string `xml:"finishOnKey,attr,omitempty"`
+
+	// Maximum length of the recording in seconds.
+	MaxLength int   `xml:"maxLength,attr,omitempty"`
+	PlayBeep  *bool `xml:"playBeep,attr,omitempty"`
+
+	// Either "trim-silence" or "do-not-trim".
+	Trim string `xml:"trim,attr,omitempty"`
+
+	RecordingStatusCallback       string `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod string `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+
+	Transcribe         bool   `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback string `xml:"transcribeCallback,attr,omitempty"`
+}
+
+func (*Record) voiceVerb() {}
+
+func (r *Record) validate() error {
+	if r.Timeout != nil && *r.Timeout < 0 {
+		return fmt.Errorf("twiml: <Record> timeout must not be negative, got %d", *r.Timeout)
+	}
+
+	if r.MaxLength < 0 {
+		return fmt.Errorf("twiml: <Record> maxLength must not be negative, got %d", r.MaxLength)
+	}
+
+	if err := validateKeys("Record", "finishOnKey", r.FinishOnKey); err != nil {
+		return err
+	}
+
+	if err := validateOneOf("Record", "trim", r.Trim, "trim-silence", "do-not-trim"); err != nil {
+		return err
+	}
+
+	if err := validateURL("Record", "action", r.Action, false); err != nil {
+		return err
+	}
+
+	if err := validateMethod("Record", r.RecordingStatusCallbackMethod); err != nil {
+		return err
+	}
+
+	return validateMethod("Record", r.Method)
+}
+
+// Hangup is the <Hangup> verb, ending the call.
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+func (*Hangup) voiceVerb() {}
+
+func (*Hangup) validate() error {
+	return nil
+}
+
+// Reject is the <Reject> verb, declining an incoming call.
+type Reject struct {
+	XMLName xml.Name `xml:"Reject"`
+
+	// Either "rejected" or "busy".
+	Reason string `xml:"reason,attr,omitempty"`
+}
+
+func (*Reject) voiceVerb() {}
+
+func (r *Reject) validate() error {
+	return validateOneOf("Reject", "reason", r.Reason, "rejected", "busy")
+}
+
+// Enqueue is the <Enqueue> verb, placing the caller in a queue.
+type Enqueue struct {
+	XMLName xml.Name `xml:"Enqueue"`
+
+	// URL Twilio requests when the caller leaves the queue, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	// URL of TwiML played while waiting in the queue, using WaitUrlMethod (GET or POST).
+	WaitUrl       string `xml:"waitUrl,attr,omitempty"`
+	WaitUrlMethod string `xml:"waitUrlMethod,attr,omitempty"`
+
+	WorkflowSid string `xml:"workflowSid,attr,omitempty"`
+
+	Name string `xml:",chardata"`
+}
+
+func (*Enqueue) voiceVerb() {}
+
+func (e *Enqueue) validate() error {
+	if e.Name == "" && e.WorkflowSid == "" {
+		return errors.New("twiml: <Enqueue> requires a queue name or workflowSid")
+	}
+
+	if err := validateURL("Enqueue", "action", e.Action, false); err != nil {
+		return err
+	}
+
+	if err := validateMethod("Enqueue", e.WaitUrlMethod); err != nil {
+		return err
+	}
+
+	return validateMethod("Enqueue", e.Method)
+}
+
+func (*Redirect) voiceVerb() {}
+
+// connectNoun is implemented by nouns allowed inside <Connect>.
+type connectNoun interface {
+	element
+	connectNoun()
+}
+
+// Connect is the <Connect> verb, connecting the call to another service such as a media stream.
+type Connect struct {
+	XMLName xml.Name `xml:"Connect"`
+
+	// URL Twilio requests when the connection ends, using Method (GET or POST).
+	Action string `xml:"action,attr,omitempty"`
+	Method string `xml:"method,attr,omitempty"`
+
+	Nouns []connectNoun
+}
+
+func (*Connect) voiceVerb() {}
+
+// Stream nests a <Stream> sending the call audio to the WebSocket at url.
+func (c *Connect) Stream(url string) *Stream {
+	s := &Stream{Url: url}
+	c.Nouns = append(c.Nouns, s)
+	return s
+}
+
+func (c *Connect) validate() error {
+	if len(c.Nouns) != 1 {
+		return fmt.Errorf("twiml: <Connect> requires exactly one noun, got %d", len(c.Nouns))
+	}
+
+	if err := c.Nouns[0].validate(); err != nil {
+		return err
+	}
+
+	if err := validateURL("Connect", "action", c.Action, false); err != nil {
+		return err
+	}
+
+	return validateMethod("Connect", c.Method)
+}
+
+// Stream is the <Stream> noun of <Connect>.
+type Stream struct {
+	XMLName xml.Name `xml:"Stream"`
+
+	// WebSocket URL, using wss scheme.
+	Url  string `xml:"url,attr"`
+	Name string `xml:"name,attr,omitempty"`
+
+	// Either "inbound_track", "outbound_track" or "both_tracks".
+	Track string `xml:"track,attr,omitempty"`
+
+	StatusCallback       string `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod string `xml:"statusCallbackMethod,attr,omitempty"`
+}
+
+func (*Stream) connectNoun() {}
+
+func (s *Stream) validate() error {
+	u, err := url.Parse(s.Url)
+	if err != nil || u.Scheme != "wss" {
+		return fmt.Errorf("twiml: <Stream> requires a wss:// URL, got %q", s.Url)
+	}
+
+	if err := validateOneOf("Stream", "track", s.Track, "inbound_track", "outbound_track", "both_tracks"); err != nil {
+		return err
+	}
+
+	return validateMethod("Stream", s.StatusCallbackMethod)
+}
+
+func validateLoop(verb string, loop *int) error {
+	if loop != nil && *loop < 0 {
+		return fmt.Errorf("twiml: <%s> loop must not be negative, got %d", verb, *loop)
+	}
+
+	return nil
+}
+
+// validateDigits checks digits to be played, which may contain w or W to pause.
+func validateDigits(verb, attr, digits string) error {
+	return validateChars(verb, attr, digits, "0123456789#*wW")
+}
+
+// validateKeys checks keys the caller may press.
+func validateKeys(verb, attr, keys string) error {
+	return validateChars(verb, attr, keys, "0123456789#*")
+}
+
+func validateChars(verb, attr, s, valid string) error {
+	for _, c := range s {
+		if !strings.ContainsRune(valid, c) {
+			return fmt.Errorf("twiml: <%s> %s contains invalid key %q", verb, attr, c)
+		}
+	}
+
+	return nil
+}
+
+// validateEvents checks call progress events of <Number>, <Client> and <Sip>.
+func validateEvents(noun, events string) error {
+	for _, e := range strings.Fields(events) {
+		if err := validateOneOf(noun, "statusCallbackEvent", e, "initiated", "ringing", "answered", "completed"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package twiml
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+
+func TestVoiceResponse_Marshal(t *testing.T) {
+	r := NewVoiceResponse()
+
+	g := r.Gather()
+	g.Input = "dtmf"
+	g.NumDigits = 1
+	g.Action = "/menu"
+	g.Say("Press 1 for sales & support").Loop = Int(2)
+	g.Pause(1)
+
+	r.Play("https://example.com/hold.mp3").Loop = Int(0)
+
+	d := r.Dial("")
+	d.Timeout = 20
+	d.AddNumber("+15558675309").SendDigits = "ww1234"
+	d.AddClient("alice")
+
+	rc := r.Record()
+	rc.Timeout = Int(0)
+	rc.PlayBeep = Bool(false)
+
+	r.Hangup()
+
+	b, err := r.Marshal()
+	if err != nil {
+		t.Fatalf("VoiceResponse.Marshal returned error: %v", err)
+	}
+
+	want := xmlHeader + `<Response>` +
+		`<Gather input="dtmf" action="/menu" numDigits="1"><Say loop="2">Press 1 for sales &amp; support</Say><Pause length="1"></Pause></Gather>` +
+		`<Play loop="0">https://example.com/hold.mp3</Play>` +
+		`<Dial timeout="20"><Number sendDigits="ww1234">+15558675309</Number><Client>alice</Client></Dial>` +
+		`<Record timeout="0" playBeep="false"></Record>` +
+		`<Hangup></Hangup>` +
+		`</Response>`
+
+	if string(b) != want {
+		t.Errorf("VoiceResponse.Marshal returned\n%s, want\n%s", b, want)
+	}
+}
+
+func TestVoiceResponse_Marshal_queueing(t *testing.T) {
+	r := NewVoiceResponse()
+	r.Say("Please hold").Voice = "alice"
+	r.Enqueue("support").WaitUrl = "/wait"
+	r.Dial("").AddConference("room").Beep = "onEnter"
+	r.Connect().Stream("wss://example.com/audio").Track = "both_tracks"
+	r.Redirect("/next")
+	r.Reject("busy")
+
+	b, err := r.Marshal()
+	if err != nil {
+		t.Fatalf("VoiceResponse.Marshal returned error: %v", err)
+	}
+
+	want := xmlHeader + `<Response>` +
+		`<Say voice="alice">Please hold</Say>` +
+		`<Enqueue waitUrl="/wait">support</Enqueue>` +
+		`<Dial><Conference beep="onEnter">room</Conference></Dial>` +
+		`<Connect><Stream url="wss://example.com/audio" track="both_tracks"></Stream></Connect>` +
+		`<Redirect>/next</Redirect>` +
+		`<Reject reason="busy"></Reject>` +
+		`</Response>`
+
+	if string(b) != want {
+		t.Errorf("VoiceResponse.Marshal returned\n%s, want\n%s", b, want)
+	}
+}
+
+func TestVoiceResponse_Validate(t *testing.T) {
+	tests := []func(r *VoiceResponse){
+		func(r *VoiceResponse) { r.Say("") },
+		func(r *VoiceResponse) { r.Play("") },
+		func(r *VoiceResponse) { r.Play("").Digits = "12x" },
+		func(r *VoiceResponse) { r.Pause(-1) },
+		func(r *VoiceResponse) { r.Gather().Input = "keypad" },
+		func(r *VoiceResponse) { r.Gather().FinishOnKey = "##" },
+		func(r *VoiceResponse) { r.Gather().FinishOnKey = "w" },
+		func(r *VoiceResponse) { r.Gather().Say("") },
+		func(r *VoiceResponse) { r.Dial("") },
+		func(r *VoiceResponse) { r.Dial("+15558675309").AddClient("alice") },
+		func(r *VoiceResponse) {
+			d := r.Dial("")
+			d.AddConference("room")
+			d.AddNumber("+15558675309")
+		},
+		func(r *VoiceResponse) { r.Dial("").AddSip("example.com") },
+		func(r *VoiceResponse) { r.Dial("").AddQueue("") },
+		func(r *VoiceResponse) { r.Dial("").AddNumber("+15558675309").StatusCallbackEvent = "initiated hungup" },
+		func(r *VoiceResponse) { r.Dial("").AddConference("room").MaxParticipants = 500 },
+		func(r *VoiceResponse) { r.Record().Trim = "trim" },
+		func(r *VoiceResponse) { r.Record().FinishOnKey = "#W" },
+		func(r *VoiceResponse) { r.Reject("later") },
+		func(r *VoiceResponse) { r.Enqueue("") },
+		func(r *VoiceResponse) { r.Connect() },
+		func(r *VoiceResponse) { r.Connect().Stream("https://example.com/audio") },
+		func(r *VoiceResponse) { r.Redirect("/next").Method = "PUT" },
+		func(r *VoiceResponse) { r.Verbs = append(r.Verbs, &Message{Body: "Hi"}) },
+	}
+
+	for i, fn := range tests {
+		r := NewVoiceResponse()
+		fn(r)
+
+		if err := r.Validate(); err == nil {
+			t.Errorf("#%d VoiceResponse.Validate expected an error to be returned", i)
+		}
+
+		if _, err := r.Marshal(); err == nil {
+			t.Errorf("#%d VoiceResponse.Marshal expected an error to be returned", i)
+		}
+	}
+}
+
+func TestVoiceResponse_Write(t *testing.T) {
+	r := NewVoiceResponse()
+	r.Say("Hello")
+
+	w := httptest.NewRecorder()
+	if err := r.Write(w); err != nil {
+		t.Fatalf("VoiceResponse.Write returned error: %v", err)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/xml" {
+		t.Errorf("Content-Type = %q, want %q", ct, "application/xml")
+	}
+
+	want := xmlHeader + `<Response><Say>Hello</Say></Response>`
+	if w.Body.String() != want {
+		t.Errorf("VoiceResponse.Write wrote %s, want %s", w.Body.String(), want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"time"
 )
 
 func CheckResponse(r *http.Response) error {
@@ -16,16 +17,48 @@ func CheckResponse(r *http.Response) error {
 
 	exception := new(Exception)
 	data, err := ioutil.ReadAll(r.Body)
-	if err == nil && data != nil {
-		json.Unmarshal(data, &exception)
+	if err == nil && len(data) > 0 {
+		if json.Unmarshal(data, &exception) != nil {
+			exception.Body = string(data)
+		}
+	}
+
+	// Non JSON response, keep at least the HTTP status
+	if exception.Status == 0 {
+		exception.Status = r.StatusCode
+	}
+
+	if exception.Message == "" {
+		exception.Message = http.StatusText(r.StatusCode)
 	}
 
 	return exception
 }
 
+// setQuery adds non blank values of v as query params of u.
+func setQuery(u *url.URL, v url.Values) {
+	q := u.Query()
+	for k, vals := range v {
+		for _, s := range vals {
+			if s != "" {
+				q.Add(k, s)
+			}
+		}
+	}
+	u.RawQuery = q.Encode()
+}
+
+// structToUrlValues converts struct as url.Values, skipping fields with zero value.
 func structToUrlValues(i interface{}) url.Values {
 	v := url.Values{}
 	m := structToMapString(i)
+	iv := reflect.ValueOf(i).Elem()
+	for j := 0; j < iv.NumField(); j++ {
+		if iv.Field(j).IsZero() {
+			delete(m, fieldName(iv.Type().Field(j)))
+		}
+	}
+
 	for k, s := range m {
 		switch {
 		case len(s) == 1:
@@ -47,7 +80,7 @@ func structToMapString(i interface{}) map[string][]string {
 	tp := iv.Type()
 
 	for i := 0; i < iv.NumField(); i++ {
-		k := tp.Field(i).Name
+		k := fieldName(tp.Field(i))
 		f := iv.Field(i)
 		ms[k] = valueToString(f)
 	}
@@ -55,10 +88,25 @@ func structToMapString(i interface{}) map[string][]string {
 	return ms
 }
 
+// fieldName returns the param name of f, which is its name unless overridden with `form` tag. Eg:
+//
+//	StartTimeAfter string `form:"StartTime>"`
+func fieldName(f reflect.StructField) string {
+	if name := f.Tag.Get("form"); name != "" {
+		return name
+	}
+
+	return f.Name
+}
+
 // valueToString converts supported type of f as slice string
 func valueToString(f reflect.Value) []string {
 	var v []string
 
+	if t, ok := f.Interface().(time.Time); ok {
+		return []string{t.UTC().Format(time.RFC3339)}
+	}
+
 	switch reflect.TypeOf(f.Interface()).Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		v = []string{strconv.FormatInt(f.Int(), 10)}
@@ -78,7 +126,21 @@ func valueToString(f reflect.Value) []string {
 		}
 	case reflect.String:
 		v = []string{f.String()}
+	case reflect.Ptr:
+		if !f.IsNil() {
+			v = valueToString(f.Elem())
+		}
 	}
 
 	return v
 }
+
+// Bool returns a pointer to v, for optional params where false is meaningful.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for optional params where zero is meaningful.
+func Int(v int) *int {
+	return &v
+}
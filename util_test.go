@@ -3,6 +3,7 @@ package twilio
 import (
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -34,6 +35,26 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_noJSON(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusBadGateway,
+		Body:       ioutil.NopCloser(strings.NewReader(`<html>Bad Gateway</html>`)),
+	}
+
+	err := CheckResponse(res).(*Exception)
+
+	want := &Exception{
+		Status:  http.StatusBadGateway,
+		Message: "Bad Gateway",
+		Body:    "<html>Bad Gateway</html>",
+	}
+
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Exception = %#v, want %#v", err, want)
+	}
+}
+
 type StructTest struct {
 	Int         int
 	Uint        uint
@@ -43,6 +64,8 @@ type StructTest struct {
 	String      string
 	SliceString []string
 	SliceInt    []int
+	PtrBool     *bool
+	PtrInt      *int
 }
 
 func TestStructToMapString(t *testing.T) {
@@ -55,6 +78,7 @@ func TestStructToMapString(t *testing.T) {
 		String:      "hello",
 		SliceString: []string{"foo", "bar"},
 		SliceInt:    []int{1, 2, 3},
+		PtrBool:     Bool(false),
 	}
 
 	w := map[string][]string{
@@ -66,7 +90,40 @@ func TestStructToMapString(t *testing.T) {
 		"String":      []string{"hello"},
 		"SliceString": []string{"foo", "bar"},
 		"SliceInt":    []string{"1", "2", "3"},
+		"PtrBool":     []string{"false"},
+		"PtrInt":      nil,
 	}
 
 	assert.Equal(t, w, structToMapString(&st))
 }
+
+func TestStructToUrlValues(t *testing.T) {
+	type params struct {
+		Body           string
+		StatusCallback string
+		Timeout        int
+		Record         bool
+		MediaUrl       []string
+		Muted          *bool
+		Limit          *int
+		StartAfter     string `form:"StartTime>"`
+		StartBefore    string `form:"StartTime<"`
+	}
+
+	p := params{
+		Body:       "Hello",
+		MediaUrl:   []string{"a.png", "b.png"},
+		Muted:      Bool(false),
+		StartAfter: "2024-01-01",
+	}
+
+	// zero values are skipped, except behind a pointer
+	w := url.Values{
+		"Body":       {"Hello"},
+		"MediaUrl":   {"a.png", "b.png"},
+		"Muted":      {"false"},
+		"StartTime>": {"2024-01-01"},
+	}
+
+	assert.Equal(t, w, structToUrlValues(&p))
+}
@@ -0,0 +1,196 @@
+package twilio
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SignatureHeader is the header in which Twilio sends the webhook request signature.
+const SignatureHeader = "X-Twilio-Signature"
+
+var (
+	ErrMissingSignature = errors.New("twilio: missing " + SignatureHeader + " header")
+	ErrInvalidSignature = errors.New("twilio: invalid webhook signature")
+)
+
+// Signature computes the signature Twilio sends for a webhook request to urlStr with the given POST params.
+// It's the base64 encoded HMAC-SHA1 of the full URL followed by every param name and value, sorted by name,
+// keyed with the account auth token.
+func Signature(authToken, urlStr string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(urlStr)
+	for _, k := range keys {
+		vals := append([]string(nil), params[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			b.WriteString(k)
+			b.WriteString(v)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateSignature reports whether signature matches a form encoded webhook request to urlStr with the given POST params.
+func ValidateSignature(authToken, signature, urlStr string, params url.Values) bool {
+	for _, u := range signatureURLs(urlStr) {
+		if hmac.Equal([]byte(signature), []byte(Signature(authToken, u, params))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateSignatureWithBody reports whether signature matches a webhook request with a JSON body. For such requests,
+// Twilio signs the URL alone and adds the hex encoded SHA-256 of the body as "bodySHA256" query param.
+func ValidateSignatureWithBody(authToken, signature, urlStr string, body []byte) bool {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return false
+	}
+
+	want, err := hex.DecodeString(u.Query().Get("bodySHA256"))
+	if err != nil {
+		return false
+	}
+
+	sum := sha256.Sum256(body)
+	if !hmac.Equal(sum[:], want) {
+		return false
+	}
+
+	return ValidateSignature(authToken, signature, urlStr, nil)
+}
+
+// signatureURLs returns urlStr along with its variant with the default port toggled, since Twilio may sign either.
+func signatureURLs(urlStr string) []string {
+	u, err := url.Parse(urlStr)
+	if err != nil || u.Host == "" {
+		return []string{urlStr}
+	}
+
+	port := map[string]string{"http": "80", "https": "443"}[u.Scheme]
+	if port == "" {
+		return []string{urlStr}
+	}
+
+	alt := *u
+	if u.Port() == "" {
+		alt.Host = net.JoinHostPort(u.Hostname(), port)
+	} else if u.Port() == port {
+		alt.Host = u.Hostname()
+	} else {
+		return []string{urlStr}
+	}
+
+	return []string{urlStr, alt.String()}
+}
+
+// WebhookValidator verifies that incoming webhook requests were sent by Twilio.
+type WebhookValidator struct {
+	// Auth token of the account the webhook belongs to.
+	AuthToken string
+
+	// URL returns the full public URL Twilio requested. Set it when running behind a proxy which rewrites
+	// the scheme, host or path. By default the URL is rebuilt from the request itself.
+	URL func(r *http.Request) string
+}
+
+// NewWebhookValidator returns a WebhookValidator using authToken.
+func NewWebhookValidator(authToken string) *WebhookValidator {
+	return &WebhookValidator{AuthToken: authToken}
+}
+
+// WebhookValidator returns a WebhookValidator using the client's AuthToken.
+func (c *Client) WebhookValidator() *WebhookValidator {
+	return NewWebhookValidator(c.AuthToken)
+}
+
+// Validate checks X-Twilio-Signature header of r. The request body is restored, so it can still be read afterwards.
+func (v *WebhookValidator) Validate(r *http.Request) error {
+	signature := r.Header.Get(SignatureHeader)
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	var body []byte
+	if r.Body != nil {
+		b, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		body = b
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	urlStr := v.requestURL(r)
+
+	if r.URL.Query().Get("bodySHA256") != "" {
+		if !ValidateSignatureWithBody(v.AuthToken, signature, urlStr, body) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	params := url.Values{}
+	if r.Method == "POST" && strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		p, err := url.ParseQuery(string(body))
+		if err != nil {
+			return err
+		}
+		params = p
+	}
+
+	if !ValidateSignature(v.AuthToken, signature, urlStr, params) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Handler wraps next, rejecting requests without a valid signature with 403 Forbidden.
+func (v *WebhookValidator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.Validate(r); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (v *WebhookValidator) requestURL(r *http.Request) string {
+	if v.URL != nil {
+		return v.URL(r)
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
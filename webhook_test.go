@@ -0,0 +1,79 @@
+package twilio
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookParams = url.Values{
+	"CallSid": {"CA1234567890ABCDE"},
+	"Caller":  {"+12349013030"},
+	"Digits":  {"1234"},
+	"From":    {"+12349013030"},
+	"To":      {"+18005551212"},
+}
+
+func TestSignature(t *testing.T) {
+	s := Signature("12345", "https://mycompany.com/myapp.php?foo=1&bar=2", webhookParams)
+	assert.Equal(t, s, "0/KCTR6DLpKmkAf8muzZqo1nDgQ=")
+}
+
+func TestValidateSignature(t *testing.T) {
+	u := "https://mycompany.com/myapp.php?foo=1&bar=2"
+
+	assert.True(t, ValidateSignature("12345", "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", u, webhookParams))
+	assert.True(t, ValidateSignature("12345", "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", "https://mycompany.com:443/myapp.php?foo=1&bar=2", webhookParams))
+	assert.False(t, ValidateSignature("12345", "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", u, url.Values{}))
+	assert.False(t, ValidateSignature("54321", "0/KCTR6DLpKmkAf8muzZqo1nDgQ=", u, webhookParams))
+}
+
+func TestValidateSignatureWithBody(t *testing.T) {
+	body := []byte(`{"property": "value", "boolean": true}`)
+	u := "https://mycompany.com/myapp.php?foo=1&bar=2&bodySHA256=0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+	signature := Signature("12345", u, nil)
+
+	assert.True(t, ValidateSignatureWithBody("12345", signature, u, body))
+	assert.False(t, ValidateSignatureWithBody("12345", signature, u, []byte(`{}`)))
+}
+
+func TestWebhookValidator_Handler(t *testing.T) {
+	c := NewClient(accountSid, authToken, nil)
+
+	h := c.WebhookValidator().Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(b)
+	}))
+
+	body := webhookParams.Encode()
+	u := "http://example.com/sms?foo=1"
+
+	r := httptest.NewRequest("POST", u, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set(SignatureHeader, Signature(authToken, u, webhookParams))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, w.Body.String(), body)
+
+	r = httptest.NewRequest("POST", u, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, w.Code, http.StatusForbidden)
+
+	r = httptest.NewRequest("POST", u, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set(SignatureHeader, "bogus")
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, w.Code, http.StatusForbidden)
+}